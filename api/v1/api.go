@@ -1,28 +1,91 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"soccer/pkg/live"
+	"soccer/pkg/models"
 	"soccer/pkg/services"
 )
 
+// Services holds the services used by the API handlers.
+type Services struct {
+	Teams        services.TeamsService
+	Players      services.PlayersService
+	Matches      services.MatchesService
+	Standings    services.StandingsService
+	Competitions services.CompetitionsService
+	Seasons      services.SeasonsService
+	MatchEvents  services.MatchEventsService
+	Stats        services.StatsService
+	Venues       services.VenuesService
+	Referees     services.RefereesService
+	Staff        services.StaffService
+	Lineups      services.LineupsService
+	Fixtures     services.FixturesService
+	Brackets     services.BracketsService
+	Squads       services.SquadsService
+	Suspensions  services.SuspensionsService
+	Injuries     services.InjuriesService
+}
+
 // API can register a set of endpoints in a router and handle
 // them using the provided storage.
 type API struct {
-	teamsService   services.TeamsService
-	playersService services.PlayersService
+	teamsService        services.TeamsService
+	playersService      services.PlayersService
+	matchesService      services.MatchesService
+	standingsService    services.StandingsService
+	competitionsService services.CompetitionsService
+	seasonsService      services.SeasonsService
+	matchEventsService  services.MatchEventsService
+	statsService        services.StatsService
+	venuesService       services.VenuesService
+	refereesService     services.RefereesService
+	staffService        services.StaffService
+	lineupsService      services.LineupsService
+	fixturesService     services.FixturesService
+	bracketsService     services.BracketsService
+	squadsService       services.SquadsService
+	suspensionsService  services.SuspensionsService
+	injuriesService     services.InjuriesService
+
+	hub *live.Hub
 
 	adminUsername string
 	adminPassword string
 }
 
-// NewAPI returns an initialized API type.
-func NewAPI(teamsService services.TeamsService,
-	playersService services.PlayersService, adminUsername, adminPassword string) *API {
+// NewAPI returns an initialized API type, with its own hub publishing the
+// changes made through it to live subscribers.
+func NewAPI(svc Services, adminUsername, adminPassword string) *API {
 	return &API{
-		teamsService:   teamsService,
-		playersService: playersService,
+		teamsService:        svc.Teams,
+		playersService:      svc.Players,
+		matchesService:      svc.Matches,
+		standingsService:    svc.Standings,
+		competitionsService: svc.Competitions,
+		seasonsService:      svc.Seasons,
+		matchEventsService:  svc.MatchEvents,
+		statsService:        svc.Stats,
+		venuesService:       svc.Venues,
+		refereesService:     svc.Referees,
+		staffService:        svc.Staff,
+		lineupsService:      svc.Lineups,
+		fixturesService:     svc.Fixtures,
+		bracketsService:     svc.Brackets,
+		squadsService:       svc.Squads,
+		suspensionsService:  svc.Suspensions,
+		injuriesService:     svc.Injuries,
+
+		hub: live.NewHub(),
 
 		adminUsername: adminUsername,
 		adminPassword: adminPassword,
@@ -31,20 +94,109 @@ func NewAPI(teamsService services.TeamsService,
 
 // Register the API's endpoints in the given router.
 func (api *API) Register(g *echo.Group) {
+	// Live API
+	g.GET("/ws", api.serveWebSocket)
+
 	// Teams API
 	g.GET("/teams", api.listTeams)
 	g.GET("/teams/:id", api.getTeam)
 	g.POST("/teams", api.createTeam, middleware.BasicAuth(api.adminValidator))
 	g.DELETE("/teams/:id", api.deleteTeam, middleware.BasicAuth(api.adminValidator))
 	g.PUT("/teams/:id", api.updateTeam, middleware.BasicAuth(api.adminValidator))
+	g.GET("/teams/:id/stats", api.getTeamStats)
+	g.GET("/teams/:id/head-to-head/:other_id", api.getHeadToHead)
+	g.GET("/teams/:id/form", api.getTeamForm)
+	g.GET("/teams/:id/fixtures.ics", api.getTeamFixturesCalendar)
+	g.GET("/teams/:id/available-numbers", api.listTeamAvailableNumbers)
+	g.GET("/teams/:id/staff", api.listTeamStaff)
+	g.GET("/teams/:id/staff/:staff_id", api.getTeamStaff)
+	g.GET("/teams/:id/coach-records", api.listTeamCoachRecords)
+	g.POST("/teams/:id/staff", api.createTeamStaff, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/teams/:id/staff/:staff_id", api.deleteTeamStaff, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/teams/:id/staff/:staff_id", api.updateTeamStaff, middleware.BasicAuth(api.adminValidator))
+	g.GET("/teams/:id/squad", api.listTeamSquad)
+	g.GET("/teams/:id/squad/compliance", api.getSquadCompliance)
+	g.POST("/teams/:id/squad", api.registerSquadPlayer, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/teams/:id/squad/:player_id", api.unregisterSquadPlayer, middleware.BasicAuth(api.adminValidator))
+	g.GET("/teams/:id/availability", api.getTeamAvailability)
 
 	// Teams API
 	g.GET("/players", api.listPlayers)
-	g.GET("/players/:team_id", api.listPlayersByTeams)
+	g.GET("/players/:id", api.listPlayersByTeams)
 	g.GET("/players/:team_id/details/:id", api.getPlayer)
 	g.POST("/players", api.createPlayer, middleware.BasicAuth(api.adminValidator))
 	g.DELETE("/players/:id", api.deletePlayer, middleware.BasicAuth(api.adminValidator))
 	g.PUT("/players/:id", api.updatePlayer, middleware.BasicAuth(api.adminValidator))
+	g.GET("/players/:id/stats", api.getPlayerStats)
+	g.GET("/players/:id/career", api.getPlayerCareer)
+	g.POST("/players/:id/transfers", api.transferPlayer, middleware.BasicAuth(api.adminValidator))
+	g.GET("/players/:id/suspensions", api.listPlayerSuspensions)
+	g.GET("/players/:id/injuries", api.listPlayerInjuries)
+	g.POST("/players/:id/injuries", api.createPlayerInjury, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/players/:id/injuries/:injury_id", api.deletePlayerInjury, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/players/:id/injuries/:injury_id", api.updatePlayerInjury, middleware.BasicAuth(api.adminValidator))
+
+	// Matches API
+	g.GET("/matches", api.listMatches)
+	g.GET("/matches/:id", api.getMatch)
+	g.POST("/matches", api.createMatch, middleware.BasicAuth(api.adminValidator))
+	g.POST("/matches/validate", api.validateMatch, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/matches/:id", api.deleteMatch, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/matches/:id", api.updateMatch, middleware.BasicAuth(api.adminValidator))
+	g.GET("/matches/:id/live", api.streamMatch)
+	g.GET("/matches/:id/events", api.listMatchEvents)
+	g.POST("/matches/:id/events", api.createMatchEvent, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/matches/:id/events/:event_id", api.deleteMatchEvent, middleware.BasicAuth(api.adminValidator))
+	g.GET("/matches/:id/officials", api.listMatchOfficials)
+	g.PUT("/matches/:id/officials/:role", api.assignMatchOfficial, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/matches/:id/officials/:role", api.unassignMatchOfficial, middleware.BasicAuth(api.adminValidator))
+	g.GET("/matches/:id/lineups/:team_id", api.getMatchLineup)
+	g.PUT("/matches/:id/lineups/:team_id", api.saveMatchLineup, middleware.BasicAuth(api.adminValidator))
+
+	// Competitions API
+	g.GET("/competitions", api.listCompetitions)
+	g.GET("/competitions/:id", api.getCompetition)
+	g.GET("/competitions/:id/seasons", api.listCompetitionSeasons)
+	g.GET("/competitions/:id/standings", api.getStandings)
+	g.GET("/competitions/:id/bracket", api.getCompetitionBracket)
+	g.GET("/competitions/:id/standings-rules", api.getStandingsRules)
+	g.PUT("/competitions/:id/standings-rules", api.updateStandingsRules, middleware.BasicAuth(api.adminValidator))
+	g.GET("/competitions/:id/squad-rules", api.getSquadRules)
+	g.PUT("/competitions/:id/squad-rules", api.updateSquadRules, middleware.BasicAuth(api.adminValidator))
+	g.GET("/competitions/:id/suspension-rules", api.getSuspensionRules)
+	g.PUT("/competitions/:id/suspension-rules", api.updateSuspensionRules, middleware.BasicAuth(api.adminValidator))
+	g.POST("/competitions", api.createCompetition, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/competitions/:id", api.deleteCompetition, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/competitions/:id", api.updateCompetition, middleware.BasicAuth(api.adminValidator))
+
+	// Seasons API
+	g.GET("/seasons/:id", api.getSeason)
+	g.GET("/seasons/:id/teams", api.listSeasonTeams)
+	g.POST("/seasons", api.createSeason, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/seasons/:id", api.deleteSeason, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/seasons/:id", api.updateSeason, middleware.BasicAuth(api.adminValidator))
+	g.POST("/seasons/:id/teams", api.registerSeasonTeam, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/seasons/:id/teams/:team_id", api.unregisterSeasonTeam, middleware.BasicAuth(api.adminValidator))
+	g.POST("/seasons/:id/fixtures", api.generateSeasonFixtures, middleware.BasicAuth(api.adminValidator))
+	g.GET("/seasons/:id/bracket", api.getBracket)
+	g.POST("/seasons/:id/bracket", api.drawBracket, middleware.BasicAuth(api.adminValidator))
+	g.GET("/seasons/:id/leaderboards/:category", api.getSeasonLeaderboard)
+
+	// Venues API
+	g.GET("/venues", api.listVenues)
+	g.GET("/venues/:id", api.getVenue)
+	g.GET("/venues/:id/matches", api.listVenueMatches)
+	g.POST("/venues", api.createVenue, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/venues/:id", api.deleteVenue, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/venues/:id", api.updateVenue, middleware.BasicAuth(api.adminValidator))
+
+	// Referees API
+	g.GET("/referees", api.listReferees)
+	g.GET("/referees/:id", api.getReferee)
+	g.GET("/referees/:id/matches", api.listRefereeMatches)
+	g.POST("/referees", api.createReferee, middleware.BasicAuth(api.adminValidator))
+	g.DELETE("/referees/:id", api.deleteReferee, middleware.BasicAuth(api.adminValidator))
+	g.PUT("/referees/:id", api.updateReferee, middleware.BasicAuth(api.adminValidator))
 }
 
 func (api *API) adminValidator(username, password string, c echo.Context) (bool, error) {
@@ -53,3 +205,117 @@ func (api *API) adminValidator(username, password string, c echo.Context) (bool,
 	}
 	return false, nil
 }
+
+// queryInt64 parses an optional integer query parameter, returning nil
+// when it is absent.
+func queryInt64(c echo.Context, name string) (*int64, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", name, value))
+	}
+
+	return &n, nil
+}
+
+// queryInt parses an optional integer query parameter, returning nil
+// when it is absent.
+func queryInt(c echo.Context, name string) (*int, error) {
+	n, err := queryInt64(c, name)
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	i := int(*n)
+	return &i, nil
+}
+
+// queryDate parses an optional YYYY-MM-DD query parameter, returning nil
+// when it is absent.
+func queryDate(c echo.Context, name string) (*time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", name, value))
+	}
+
+	return &date, nil
+}
+
+// queryBool parses an optional boolean query parameter, returning false
+// when it is absent.
+func queryBool(c echo.Context, name string) (bool, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", name, value))
+	}
+
+	return b, nil
+}
+
+// Page sizes of paginated lists.
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// queryPagination parses the optional page and per_page query parameters,
+// defaulting to the first page of defaultPerPage items.
+func queryPagination(c echo.Context) (models.Pagination, error) {
+	pagination := models.Pagination{Page: 1, PerPage: defaultPerPage}
+
+	page, err := queryInt(c, "page")
+	if err != nil {
+		return models.Pagination{}, err
+	}
+	if page != nil {
+		if *page < 1 {
+			return models.Pagination{}, echo.NewHTTPError(http.StatusBadRequest, "page must be positive")
+		}
+		pagination.Page = *page
+	}
+
+	perPage, err := queryInt(c, "per_page")
+	if err != nil {
+		return models.Pagination{}, err
+	}
+	if perPage != nil {
+		if *perPage < 1 || *perPage > maxPerPage {
+			return models.Pagination{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("per_page must be between 1 and %d", maxPerPage))
+		}
+		pagination.PerPage = *perPage
+	}
+
+	return pagination, nil
+}
+
+// serviceError translates the typed errors returned by the services into
+// HTTP errors, leaving any other error untouched.
+func serviceError(err error) error {
+	var validationErr *services.ValidationError
+	if errors.As(err, &validationErr) {
+		return echo.NewHTTPError(http.StatusBadRequest, validationErr.Problems)
+	}
+	var conflictErr *services.ConflictError
+	if errors.As(err, &conflictErr) {
+		return echo.NewHTTPError(http.StatusConflict, conflictErr.Message)
+	}
+	var scheduleErr *services.ScheduleConflictError
+	if errors.As(err, &scheduleErr) {
+		return echo.NewHTTPError(http.StatusConflict, scheduleErr.Conflicts)
+	}
+	return err
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get a competition bracket
+// @Summary Get a competition bracket
+// @Description Get the knockout bracket of a cup competition as the tree of ties leading to the final, for its current season unless a season is given
+// @Tags competitions
+// @ID get-competition-bracket
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param season_id query int false "Season ID"
+// @Success 200 {object} models.Bracket
+// @Router /competitions/{id}/bracket [get]
+func (api *API) getCompetitionBracket(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasonID, err := queryInt64(c, "season_id")
+	if err != nil {
+		return err
+	}
+
+	var season models.Season
+	if seasonID == nil {
+		season, err = api.seasonsService.GetCurrentSeason(ctx, id)
+	} else {
+		season, err = api.seasonsService.GetSeason(ctx, *seasonID)
+	}
+	if err != nil {
+		return err
+	}
+	if season.CompetitionID != id {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("season %d is not a season of competition %d", season.ID, id))
+	}
+
+	return api.bracket(c, season.ID)
+}
+
+// Get a season bracket
+// @Summary Get a season bracket
+// @Description Get the knockout bracket of a cup competition season as the tree of ties leading to the final
+// @Tags seasons
+// @ID get-bracket
+// @Produce json
+// @Param id path int true "Season ID"
+// @Success 200 {object} models.Bracket
+// @Router /seasons/{id}/bracket [get]
+func (api *API) getBracket(c echo.Context) error {
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	return api.bracket(c, id)
+}
+
+// bracket responds with the bracket of a season.
+func (api *API) bracket(c echo.Context, seasonID int64) error {
+	ctx := c.Request().Context()
+
+	bracket, err := api.bracketsService.GetBracket(ctx, seasonID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, bracket)
+}
+
+// Draw a season bracket
+// @Summary Draw a season bracket
+// @Description Draw the single-elimination bracket of a cup competition season from its teams, best seed first; the best seeds get byes when the number of teams is not a power of two, and first round scheduling conflicts are rejected with 409 unless forced
+// @Tags seasons
+// @ID draw-bracket
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param draw body models.BracketDraw true "Bracket draw"
+// @Param force query bool false "Draw the bracket despite scheduling conflicts"
+// @Success 201 {object} models.Bracket
+// @Router /seasons/{id}/bracket [post]
+func (api *API) drawBracket(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	force, err := queryBool(c, "force")
+	if err != nil {
+		return err
+	}
+
+	draw := new(models.BracketDraw)
+	if err := c.Bind(draw); err != nil {
+		return err
+	}
+
+	if err := c.Validate(draw); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	draw.SeasonID = id
+	bracket, err := api.bracketsService.DrawBracket(ctx, *draw, force)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, bracket)
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_getBracket(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/seasons/4/bracket", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/bracket")
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+
+	home, away, matchID := int64(1), int64(2), int64(5)
+	kickoff := time.Date(2020, 9, 1, 19, 0, 0, 0, time.UTC)
+	seasonID := int64(4)
+	bracket := models.Bracket{
+		CompetitionID: 1,
+		SeasonID:      4,
+		Rounds:        1,
+		Final: models.BracketNode{
+			ID: 3, CompetitionID: 1, SeasonID: &seasonID, Round: 1, HomeTeamID: &home, AwayTeamID: &away,
+			MatchID: &matchID, Kickoff: kickoff,
+		},
+	}
+
+	mockBracketsService := &mocks.BracketsService{}
+	mockBracketsService.On("GetBracket", mock.Anything, int64(4)).Return(bracket, nil)
+
+	api := NewAPI(Services{Brackets: mockBracketsService}, "", "")
+	if assert.NoError(t, api.getBracket(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"competition_id\":1,\"season_id\":4,\"rounds\":1,\"final\":{\"id\":3,\"competition_id\":1,\"season_id\":4,\"round\":1,\"position\":0,\"home_team_id\":1,\"away_team_id\":2,\"winner_team_id\":null,\"match_id\":5,\"kickoff\":\"2020-09-01T19:00:00Z\"}}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getCompetitionBracket(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1/bracket", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/bracket")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	bracket := models.Bracket{CompetitionID: 1, SeasonID: 4, Rounds: 1}
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("GetCurrentSeason", mock.Anything, int64(1)).Return(models.Season{ID: 4, CompetitionID: 1}, nil)
+	mockBracketsService := &mocks.BracketsService{}
+	mockBracketsService.On("GetBracket", mock.Anything, int64(4)).Return(bracket, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService, Brackets: mockBracketsService}, "", "")
+	if assert.NoError(t, api.getCompetitionBracket(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"season_id\":4")
+	}
+}
+
+func TestAPI_getCompetitionBracketSeason(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1/bracket?season_id=3", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/bracket")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	bracket := models.Bracket{CompetitionID: 1, SeasonID: 3, Rounds: 1}
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("GetSeason", mock.Anything, int64(3)).Return(models.Season{ID: 3, CompetitionID: 1}, nil)
+	mockBracketsService := &mocks.BracketsService{}
+	mockBracketsService.On("GetBracket", mock.Anything, int64(3)).Return(bracket, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService, Brackets: mockBracketsService}, "", "")
+	if assert.NoError(t, api.getCompetitionBracket(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"season_id\":3")
+	}
+}
+
+func TestAPI_getCompetitionBracketOtherCompetition(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1/bracket?season_id=3", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/bracket")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("GetSeason", mock.Anything, int64(3)).Return(models.Season{ID: 3, CompetitionID: 2}, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	err := api.getCompetitionBracket(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_drawBracketNotACup(t *testing.T) {
+	body := `{"team_ids":[1,2,3],"first_kickoff":"2020-09-01T19:00:00Z","days_between_rounds":7}`
+	req := httptest.NewRequest(http.MethodPost, "/seasons/4/bracket", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/bracket")
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+
+	draw := models.BracketDraw{
+		SeasonID:          4,
+		TeamIDs:           []int64{1, 2, 3},
+		FirstKickoff:      time.Date(2020, 9, 1, 19, 0, 0, 0, time.UTC),
+		DaysBetweenRounds: 7,
+	}
+
+	mockBracketsService := &mocks.BracketsService{}
+	mockBracketsService.On("DrawBracket", mock.Anything, draw, false).
+		Return(models.Bracket{}, services.NewValidationError("competition 1 is not a cup"))
+
+	api := NewAPI(Services{Brackets: mockBracketsService}, "", "")
+	err := api.drawBracket(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
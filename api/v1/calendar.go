@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Calendar rendering settings.
+const (
+	calendarMatchLength = 2 * time.Hour
+	calendarLineLength  = 75
+	calendarTimeFormat  = "20060102T150405Z"
+)
+
+// Get team fixtures calendar
+// @Summary Get team fixtures calendar
+// @Description Get the past and upcoming matches of a team as an iCalendar (RFC 5545) feed for calendar apps to subscribe to
+// @Tags teams
+// @ID get-team-fixtures-calendar
+// @Produce text/calendar
+// @Param id path int true "Team ID"
+// @Success 200 {string} string ""
+// @Router /teams/{id}/fixtures.ics [get]
+func (api *API) getTeamFixturesCalendar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	team, err := api.teamsService.GetTeam(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	matches, err := api.matchesService.ListTeamCalendar(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", []byte(renderCalendar(team.Name, matches)))
+}
+
+// renderCalendar writes the matches of a team as an iCalendar. Each match
+// keeps the same UID so that updates replace the event in calendar apps.
+func renderCalendar(teamName string, matches []models.CalendarMatch) string {
+	var b strings.Builder
+	line := func(name, value string) {
+		b.WriteString(foldCalendarLine(name + ":" + value))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//soccer-api//fixtures//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escapeCalendarText(teamName+" fixtures"))
+
+	for _, match := range matches {
+		summary := fmt.Sprintf("%s vs %s", match.HomeTeamName, match.AwayTeamName)
+		if match.Status == models.MatchLive || match.Status == models.MatchFinished {
+			summary = fmt.Sprintf("%s %d-%d %s", match.HomeTeamName, match.HomeScore, match.AwayScore, match.AwayTeamName)
+		}
+
+		stamp := match.Kickoff
+		if match.UpdatedAt != nil {
+			stamp = *match.UpdatedAt
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("match-%d@soccer-api", match.ID))
+		line("DTSTAMP", stamp.UTC().Format(calendarTimeFormat))
+		line("DTSTART", match.Kickoff.UTC().Format(calendarTimeFormat))
+		line("DTEND", match.Kickoff.Add(calendarMatchLength).UTC().Format(calendarTimeFormat))
+		line("SEQUENCE", strconv.Itoa(match.Sequence))
+		line("SUMMARY", escapeCalendarText(summary))
+		if match.CompetitionName != nil {
+			line("DESCRIPTION", escapeCalendarText(*match.CompetitionName))
+		}
+		if match.VenueName != nil {
+			location := *match.VenueName
+			if match.VenueCity != nil && *match.VenueCity != "" {
+				location += ", " + *match.VenueCity
+			}
+			line("LOCATION", escapeCalendarText(location))
+		}
+		line("STATUS", calendarStatus(match.Status))
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return b.String()
+}
+
+// calendarStatus maps a match status to the status of its event.
+func calendarStatus(status string) string {
+	switch status {
+	case models.MatchCancelled:
+		return "CANCELLED"
+	case models.MatchPostponed:
+		return "TENTATIVE"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+// escapeCalendarText escapes a TEXT property value.
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// foldCalendarLine splits a content line longer than 75 octets, each
+// continuation starting with a space, without breaking UTF-8 characters.
+func foldCalendarLine(line string) string {
+	var b strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > calendarLineLength {
+			b.WriteString("\r\n ")
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_getTeamFixturesCalendar(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/fixtures.ics", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/fixtures.ics")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	competition, venue, city := "Liga 1", "Gelora Bung Karno", "Jakarta"
+	updated := time.Date(2020, 4, 22, 8, 30, 0, 0, time.UTC)
+	matches := []models.CalendarMatch{
+		{
+			ID:              1,
+			CompetitionName: &competition,
+			HomeTeamName:    "Persija",
+			AwayTeamName:    "Persib",
+			Kickoff:         time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+			VenueName:       &venue,
+			VenueCity:       &city,
+			Status:          models.MatchFinished,
+			HomeScore:       2,
+			AwayScore:       1,
+			UpdatedAt:       &updated,
+		},
+		{
+			ID:           2,
+			HomeTeamName: "Arema",
+			AwayTeamName: "Persija",
+			Kickoff:      time.Date(2020, 5, 2, 12, 0, 0, 0, time.UTC),
+			Status:       models.MatchPostponed,
+			Sequence:     1,
+			UpdatedAt:    &updated,
+		},
+	}
+
+	mockTeamsService := &mocks.TeamsService{}
+	mockTeamsService.On("GetTeam", mock.Anything, int64(1)).Return(models.Team{ID: 1, Name: "Persija"}, nil)
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("ListTeamCalendar", mock.Anything, int64(1)).Return(matches, nil)
+
+	api := NewAPI(Services{Teams: mockTeamsService, Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.getTeamFixturesCalendar(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//soccer-api//fixtures//EN",
+			"CALSCALE:GREGORIAN",
+			"METHOD:PUBLISH",
+			"X-WR-CALNAME:Persija fixtures",
+			"BEGIN:VEVENT",
+			"UID:match-1@soccer-api",
+			"DTSTAMP:20200422T083000Z",
+			"DTSTART:20200421T150000Z",
+			"DTEND:20200421T170000Z",
+			"SEQUENCE:0",
+			"SUMMARY:Persija 2-1 Persib",
+			"DESCRIPTION:Liga 1",
+			"LOCATION:Gelora Bung Karno\\, Jakarta",
+			"STATUS:CONFIRMED",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:match-2@soccer-api",
+			"DTSTAMP:20200422T083000Z",
+			"DTSTART:20200502T120000Z",
+			"DTEND:20200502T140000Z",
+			"SEQUENCE:1",
+			"SUMMARY:Arema vs Persija",
+			"STATUS:TENTATIVE",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n"), rec.Body.String())
+	}
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	line := "SUMMARY:" + strings.Repeat("é", 40)
+
+	folded := foldCalendarLine(line)
+
+	parts := strings.Split(folded, "\r\n ")
+	assert.Equal(t, line, strings.Join(parts, ""))
+	for _, part := range parts {
+		assert.True(t, len(part) <= 75)
+	}
+	assert.Len(t, parts, 2)
+}
+
+func TestEscapeCalendarText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne`, escapeCalendarText("a\\b;c,d\ne"))
+}
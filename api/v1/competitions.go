@@ -0,0 +1,164 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// List competitions
+// @Summary List competitions
+// @Description Get the list of competitions
+// @Tags competitions
+// @ID list-competitions
+// @Produce json
+// @Success 200 {array} models.Competition
+// @Router /competitions [get]
+func (api *API) listCompetitions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	competitions, err := api.competitionsService.ListCompetitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, competitions)
+}
+
+// Get a competition
+// @Summary Get a competition
+// @Description Get a competition by id
+// @Tags competitions
+// @ID get-competition
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Success 200 {object} models.Competition
+// @Router /competitions/{id} [get]
+func (api *API) getCompetition(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	competition, err := api.competitionsService.GetCompetition(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, competition)
+}
+
+// Create a new competition
+// @Summary Create a new competition
+// @Description Create a new competition
+// @Tags competitions
+// @ID create-competition
+// @Produce json
+// @Param competition body models.Competition true "Create competition"
+// @Success 201 {object} models.Competition
+// @Router /competitions [post]
+func (api *API) createCompetition(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	competition := new(models.Competition)
+	if err := c.Bind(competition); err != nil {
+		return err
+	}
+
+	if err := c.Validate(competition); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	newCompetition, err := api.competitionsService.CreateCompetition(ctx, *competition)
+	if err != nil {
+		return err
+	}
+	api.hub.Publish([]string{live.CompetitionTopic(newCompetition.ID)}, live.TypeCompetition, newCompetition)
+
+	return c.JSON(http.StatusCreated, newCompetition)
+}
+
+// Delete a competition
+// @Summary Delete a competition
+// @Description Delete a competition by id
+// @Tags competitions
+// @ID delete-competition
+// @Produce plain
+// @Param id path int true "Competition ID"
+// @Success 204 {string} string ""
+// @Router /competitions/{id} [delete]
+func (api *API) deleteCompetition(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	if err := api.competitionsService.DeleteCompetition(ctx, id); err != nil {
+		return err
+	}
+	api.hub.Publish([]string{live.CompetitionTopic(id)}, live.TypeCompetitionDeleted, map[string]int64{"id": id})
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a competition
+// @Summary Update a competition
+// @Description Update a competition
+// @Tags competitions
+// @ID update-competition
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param competition body models.Competition true "Update competition"
+// @Success 201 {object} models.Competition
+// @Router /competitions/{id} [put]
+func (api *API) updateCompetition(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	competition := new(models.Competition)
+	if err := c.Bind(competition); err != nil {
+		return err
+	}
+
+	if err := c.Validate(competition); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	competition.ID = id
+	updatedCompetition, err := api.competitionsService.UpdateCompetition(ctx, *competition)
+	if err != nil {
+		return err
+	}
+	api.hub.Publish([]string{live.CompetitionTopic(updatedCompetition.ID)}, live.TypeCompetition, updatedCompetition)
+
+	return c.JSON(http.StatusCreated, updatedCompetition)
+}
+
+// List competition seasons
+// @Summary List competition seasons
+// @Description Get the list of seasons of a competition
+// @Tags competitions
+// @ID list-competition-seasons
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Success 200 {array} models.Season
+// @Router /competitions/{id}/seasons [get]
+func (api *API) listCompetitionSeasons(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasons, err := api.seasonsService.ListSeasons(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, seasons)
+}
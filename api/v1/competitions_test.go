@@ -0,0 +1,120 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listCompetitions(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	mockCompetitionsService := &mocks.CompetitionsService{}
+	mockCompetitionsService.On("ListCompetitions", mock.Anything).Return([]models.Competition{}, nil)
+
+	api := NewAPI(Services{Competitions: mockCompetitionsService}, "", "")
+	if assert.NoError(t, api.listCompetitions(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getCompetition(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	competition := models.Competition{ID: 1, Name: "Liga 1", Country: "ID", Type: models.CompetitionLeague}
+
+	mockCompetitionsService := &mocks.CompetitionsService{}
+	mockCompetitionsService.On("GetCompetition", mock.Anything, int64(1)).Return(competition, nil)
+
+	api := NewAPI(Services{Competitions: mockCompetitionsService}, "", "")
+	if assert.NoError(t, api.getCompetition(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"name\":\"Liga 1\",\"country\":\"ID\",\"type\":\"league\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createCompetition(t *testing.T) {
+	competition := models.Competition{Name: "Liga 1", Country: "ID"}
+	competitionJSON, _ := json.Marshal(competition)
+
+	req := httptest.NewRequest(http.MethodPost, "/competitions", bytes.NewReader(competitionJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := competition
+	created.ID = 1
+	created.Type = models.CompetitionLeague
+
+	mockCompetitionsService := &mocks.CompetitionsService{}
+	mockCompetitionsService.On("CreateCompetition", mock.Anything, competition).Return(created, nil)
+
+	api := NewAPI(Services{Competitions: mockCompetitionsService}, "", "")
+	if assert.NoError(t, api.createCompetition(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"name\":\"Liga 1\",\"country\":\"ID\",\"type\":\"league\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_deleteCompetition(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/competitions/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockCompetitionsService := &mocks.CompetitionsService{}
+	mockCompetitionsService.On("DeleteCompetition", mock.Anything, int64(1)).Return(nil)
+
+	api := NewAPI(Services{Competitions: mockCompetitionsService}, "", "")
+	if assert.NoError(t, api.deleteCompetition(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
+
+func TestAPI_listCompetitionSeasons(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1/seasons", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/seasons")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("ListSeasons", mock.Anything, int64(1)).Return([]models.Season{}, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	if assert.NoError(t, api.listCompetitionSeasons(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
@@ -31,47 +31,47 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/players": {
+        "/competitions": {
             "get": {
-                "description": "Get the list of players",
+                "description": "Get the list of competitions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "List players",
-                "operationId": "list-players",
+                "summary": "List competitions",
+                "operationId": "list-competitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Player"
+                                "$ref": "#/definitions/models.Competition"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new player",
+                "description": "Create a new competition",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "Create a new player",
-                "operationId": "create-player",
+                "summary": "Create a new competition",
+                "operationId": "create-competition",
                 "parameters": [
                     {
-                        "description": "Create player",
-                        "name": "player",
+                        "description": "Create competition",
+                        "name": "competition",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Player"
+                            "$ref": "#/definitions/models.Competition"
                         }
                     }
                 ],
@@ -79,38 +79,66 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Player"
+                            "$ref": "#/definitions/models.Competition"
                         }
                     }
                 }
             }
         },
-        "/players/{id}": {
+        "/competitions/{id}": {
+            "get": {
+                "description": "Get a competition by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Get a competition",
+                "operationId": "get-competition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Competition"
+                        }
+                    }
+                }
+            },
             "put": {
-                "description": "Update an player",
+                "description": "Update a competition",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "Update an player",
-                "operationId": "update-player",
+                "summary": "Update a competition",
+                "operationId": "update-competition",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Player ID",
+                        "description": "Competition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update player",
-                        "name": "player",
+                        "description": "Update competition",
+                        "name": "competition",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Player"
+                            "$ref": "#/definitions/models.Competition"
                         }
                     }
                 ],
@@ -118,25 +146,25 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.Competition"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete an player by id",
+                "description": "Delete a competition by id",
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "Delete an player",
-                "operationId": "delete-player",
+                "summary": "Delete a competition",
+                "operationId": "delete-competition",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Player ID",
+                        "description": "Competition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -152,22 +180,58 @@ var doc = `{
                 }
             }
         },
-        "/players/{team_id}": {
+        "/competitions/{id}/bracket": {
             "get": {
-                "description": "Get the list of players by team",
+                "description": "Get the knockout bracket of a cup competition as the tree of ties leading to the final, for its current season unless a season is given",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "List players by team",
-                "operationId": "list-players-team",
+                "summary": "Get a competition bracket",
+                "operationId": "get-competition-bracket",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Team ID",
-                        "name": "team_id",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bracket"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{id}/seasons": {
+            "get": {
+                "description": "Get the list of seasons of a competition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "List competition seasons",
+                "operationId": "list-competition-seasons",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -178,28 +242,28 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Player"
+                                "$ref": "#/definitions/models.Season"
                             }
                         }
                     }
                 }
             }
         },
-        "/players/{team_id}/detail/{id}": {
+        "/competitions/{id}/squad-rules": {
             "get": {
-                "description": "Get an player by id",
+                "description": "Get the rules the season squads of a competition must follow",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "competitions"
                 ],
-                "summary": "Get an player",
-                "operationId": "get-player",
+                "summary": "Get competition squad rules",
+                "operationId": "get-squad-rules",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Player ID",
+                        "description": "Competition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -209,53 +273,142 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Player"
+                            "$ref": "#/definitions/models.SquadRules"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set the rules the season squads of a competition must follow; omitted rules are not enforced",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Update competition squad rules",
+                "operationId": "update-squad-rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update squad rules",
+                        "name": "rules",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SquadRules"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SquadRules"
                         }
                     }
                 }
             }
         },
-        "/teams": {
+        "/competitions/{id}/standings": {
             "get": {
-                "description": "Get the list of teams",
+                "description": "Get the league table of a competition computed from its finished matches, optionally scoped to a season, with teams level on points ordered by the competition tiebreakers",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "competitions"
+                ],
+                "summary": "Get competition standings",
+                "operationId": "get-standings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List teams",
-                "operationId": "list-teams",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Team"
+                                "$ref": "#/definitions/models.Standing"
                             }
                         }
                     }
                 }
+            }
+        },
+        "/competitions/{id}/standings-rules": {
+            "get": {
+                "description": "Get the ordered tiebreakers a competition applies to teams level on points",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "competitions"
+                ],
+                "summary": "Get competition standings rules",
+                "operationId": "get-standings-rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandingsRules"
+                        }
+                    }
+                }
             },
-            "post": {
-                "description": "Create a new team",
+            "put": {
+                "description": "Set the ordered tiebreakers a competition applies to teams level on points, out of goal_difference, goals_scored, head_to_head_points, head_to_head_goal_difference, head_to_head_goals_scored, away_goals, fair_play and drawing_of_lots",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "competitions"
                 ],
-                "summary": "Create a new team",
-                "operationId": "create-team",
+                "summary": "Update competition standings rules",
+                "operationId": "update-standings-rules",
                 "parameters": [
                     {
-                        "description": "Create team",
-                        "name": "team",
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update standings rules",
+                        "name": "rules",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Team"
+                            "$ref": "#/definitions/models.StandingsRules"
                         }
                     }
                 ],
@@ -263,27 +416,27 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Team"
+                            "$ref": "#/definitions/models.StandingsRules"
                         }
                     }
                 }
             }
         },
-        "/teams/{id}": {
+        "/competitions/{id}/suspension-rules": {
             "get": {
-                "description": "Get an team by id",
+                "description": "Get how many matches red cards and accumulated yellow cards ban players for in a competition",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "competitions"
                 ],
-                "summary": "Get an team",
-                "operationId": "get-team",
+                "summary": "Get competition suspension rules",
+                "operationId": "get-suspension-rules",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Team ID",
+                        "description": "Competition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -293,36 +446,36 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Team"
+                            "$ref": "#/definitions/models.SuspensionRules"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an team",
+                "description": "Set how many matches red cards and accumulated yellow cards ban players for in a competition",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "competitions"
                 ],
-                "summary": "Update an team",
-                "operationId": "update-team",
+                "summary": "Update competition suspension rules",
+                "operationId": "update-suspension-rules",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Team ID",
+                        "description": "Competition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update team",
-                        "name": "team",
+                        "description": "Update suspension rules",
+                        "name": "rules",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Team"
+                            "$ref": "#/definitions/models.SuspensionRules"
                         }
                     }
                 ],
@@ -330,58 +483,3595 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.SuspensionRules"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete an team by id",
+            }
+        },
+        "/matches": {
+            "get": {
+                "description": "Get the list of matches ordered by kickoff",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "teams"
+                    "matches"
                 ],
-                "summary": "Delete an team",
-                "operationId": "delete-team",
+                "summary": "List matches",
+                "operationId": "list-matches",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Team ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "team_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "venue_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "scheduled",
+                            "live",
+                            "finished",
+                            "postponed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Match status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Match"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new match, filed under the competition of its season; scheduling conflicts are rejected with 409 unless forced, in which case they are listed with the match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Create a new match",
+                "operationId": "create-match",
+                "parameters": [
+                    {
+                        "description": "Create match",
+                        "name": "match",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create the match despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
                         }
                     }
                 }
             }
-        }
-    },
+        },
+        "/matches/validate": {
+            "post": {
+                "description": "Dry-run the scheduling checks of creating a match, or of rescheduling it when the id is set, and list every conflict found",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Validate a match schedule",
+                "operationId": "validate-match",
+                "parameters": [
+                    {
+                        "description": "Match to validate",
+                        "name": "match",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Conflict"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}": {
+            "get": {
+                "description": "Get a match by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Get a match",
+                "operationId": "get-match",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a match; finishing a knockout tie sends its winner, on penalties when level, to the next round, and rescheduling conflicts are rejected with 409 unless forced",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Update a match",
+                "operationId": "update-match",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update match",
+                        "name": "match",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Reschedule the match despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Match"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a match by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Delete a match",
+                "operationId": "delete-match",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/events": {
+            "get": {
+                "description": "Get the timeline of a match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "List match events",
+                "operationId": "list-match-events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MatchEvent"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Append an event to the timeline of a match, for players of the team they belonged to at kickoff; goal events update the match score",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Create a new match event",
+                "operationId": "create-match-event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create match event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MatchEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MatchEvent"
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/events/{event_id}": {
+            "delete": {
+                "description": "Delete an event from the timeline of a match",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Delete a match event",
+                "operationId": "delete-match-event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/lineups/{team_id}": {
+            "get": {
+                "description": "Get the formation, starters and substitutes of a team in a match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Get a match lineup",
+                "operationId": "get-match-lineup",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "team_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Lineup"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Submit the formation, eleven starters with one goalkeeper and the substitutes of a team in a match, replacing any previous lineup; suspended players are rejected",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Submit a match lineup",
+                "operationId": "save-match-lineup",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "team_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Submit lineup",
+                        "name": "lineup",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Lineup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Lineup"
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/live": {
+            "get": {
+                "description": "Stream the changes to a match as Server-Sent Events: the match itself on connection and whenever its score or status changes (match) or it is deleted (match_deleted), the events added to (event_created) or removed from (event_deleted) its timeline, its lineups (lineup) and its officials (official, official_deleted)",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Follow a match live",
+                "operationId": "stream-match",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/officials": {
+            "get": {
+                "description": "Get the officials assigned to a match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "List match officials",
+                "operationId": "list-match-officials",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MatchOfficial"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}/officials/{role}": {
+            "put": {
+                "description": "Assign a referee to a match in the given role, replacing any referee already in that role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Assign a match official",
+                "operationId": "assign-match-official",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "referee",
+                            "assistant_1",
+                            "assistant_2",
+                            "fourth_official"
+                        ],
+                        "type": "string",
+                        "description": "Official role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assign official",
+                        "name": "official",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MatchOfficial"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MatchOfficial"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the referee assigned to a match in the given role",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Unassign a match official",
+                "operationId": "unassign-match-official",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "referee",
+                            "assistant_1",
+                            "assistant_2",
+                            "fourth_official"
+                        ],
+                        "type": "string",
+                        "description": "Official role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/players": {
+            "get": {
+                "description": "Get the list of players",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "List players",
+                "operationId": "list-players",
+                "parameters": [
+                    {
+                        "enum": [
+                            "GK",
+                            "DF",
+                            "MF",
+                            "FW"
+                        ],
+                        "type": "string",
+                        "description": "Position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Detailed role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 3166-1 alpha-2 country code",
+                        "name": "nationality",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "left",
+                            "right",
+                            "both"
+                        ],
+                        "type": "string",
+                        "description": "Preferred foot",
+                        "name": "preferred_foot",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum age",
+                        "name": "min_age",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum age",
+                        "name": "max_age",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum height in centimeters",
+                        "name": "min_height",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum height in centimeters",
+                        "name": "max_height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Player"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Create a new player",
+                "operationId": "create-player",
+                "parameters": [
+                    {
+                        "description": "Create player",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}": {
+            "get": {
+                "description": "Get the list of players by team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "List players by team",
+                "operationId": "list-players-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "GK",
+                            "DF",
+                            "MF",
+                            "FW"
+                        ],
+                        "type": "string",
+                        "description": "Position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Detailed role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 3166-1 alpha-2 country code",
+                        "name": "nationality",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "left",
+                            "right",
+                            "both"
+                        ],
+                        "type": "string",
+                        "description": "Preferred foot",
+                        "name": "preferred_foot",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum age",
+                        "name": "min_age",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum age",
+                        "name": "max_age",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum height in centimeters",
+                        "name": "min_height",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum height in centimeters",
+                        "name": "max_height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Player"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an player",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Update an player",
+                "operationId": "update-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update player",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an player by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Delete an player",
+                "operationId": "delete-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/career": {
+            "get": {
+                "description": "Get every team a player has been at, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get a player career",
+                "operationId": "get-player-career",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PlayerMembership"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/injuries": {
+            "get": {
+                "description": "Get the injury record of a player, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "List player injuries",
+                "operationId": "list-player-injuries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Injury"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record an injury of a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Create a new player injury",
+                "operationId": "create-player-injury",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create injury",
+                        "name": "injury",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Injury"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Injury"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/injuries/{injury_id}": {
+            "put": {
+                "description": "Update an injury of a player, e.g. to record their return",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Update a player injury",
+                "operationId": "update-player-injury",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Injury ID",
+                        "name": "injury_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update injury",
+                        "name": "injury",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Injury"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Injury"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an injury of a player by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Delete a player injury",
+                "operationId": "delete-player-injury",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Injury ID",
+                        "name": "injury_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/stats": {
+            "get": {
+                "description": "Get appearances, minutes, goals, assists and cards of a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get player statistics",
+                "operationId": "get-player-stats",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PlayerStats"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/suspensions": {
+            "get": {
+                "description": "Get the bans a player picked up from cards in each season of a competition, with the matches they cover and how many remain to be served",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "List player suspensions",
+                "operationId": "list-player-suspensions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Suspension"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{id}/transfers": {
+            "post": {
+                "description": "Move a player to another team, closing the current membership and opening a new one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Transfer a player",
+                "operationId": "transfer-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Transfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PlayerMembership"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{team_id}/detail/{id}": {
+            "get": {
+                "description": "Get an player by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get an player",
+                "operationId": "get-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    }
+                }
+            }
+        },
+        "/referees": {
+            "get": {
+                "description": "Get the list of referees",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "List referees",
+                "operationId": "list-referees",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Referee"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new referee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "Create a new referee",
+                "operationId": "create-referee",
+                "parameters": [
+                    {
+                        "description": "Create referee",
+                        "name": "referee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Referee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Referee"
+                        }
+                    }
+                }
+            }
+        },
+        "/referees/{id}": {
+            "get": {
+                "description": "Get a referee by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "Get a referee",
+                "operationId": "get-referee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Referee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Referee"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a referee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "Update a referee",
+                "operationId": "update-referee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Referee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update referee",
+                        "name": "referee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Referee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Referee"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a referee by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "Delete a referee",
+                "operationId": "delete-referee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Referee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/referees/{id}/matches": {
+            "get": {
+                "description": "Get every match a referee has been assigned to, and their role in it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referees"
+                ],
+                "summary": "List referee matches",
+                "operationId": "list-referee-matches",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Referee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RefereeAssignment"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons": {
+            "post": {
+                "description": "Create a new season of a competition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Create a new season",
+                "operationId": "create-season",
+                "parameters": [
+                    {
+                        "description": "Create season",
+                        "name": "season",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Season"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Season"
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}": {
+            "get": {
+                "description": "Get a season by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Get a season",
+                "operationId": "get-season",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Season"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Update a season",
+                "operationId": "update-season",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update season",
+                        "name": "season",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Season"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Season"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a season by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Delete a season",
+                "operationId": "delete-season",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}/bracket": {
+            "get": {
+                "description": "Get the knockout bracket of a cup competition season as the tree of ties leading to the final",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Get a season bracket",
+                "operationId": "get-bracket",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bracket"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Draw the single-elimination bracket of a cup competition season from its teams, best seed first; the best seeds get byes when the number of teams is not a power of two, and first round scheduling conflicts are rejected with 409 unless forced",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Draw a season bracket",
+                "operationId": "draw-bracket",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bracket draw",
+                        "name": "draw",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BracketDraw"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Draw the bracket despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bracket"
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}/fixtures": {
+            "post": {
+                "description": "Schedule a single or double round-robin between the teams registered in a season, one round every few days from the first kickoff; scheduling conflicts are rejected with 409 unless forced, in which case they are listed with their matches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Generate season fixtures",
+                "operationId": "generate-season-fixtures",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fixture schedule",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FixtureSchedule"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Generate the fixtures despite scheduling conflicts",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Match"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}/leaderboards/{category}": {
+            "get": {
+                "description": "Get a page of the players of a season ranked by goals, assists, clean sheets or cards, ties going to fewer minutes played",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Get season leaderboard",
+                "operationId": "get-season-leaderboard",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "goals",
+                            "assists",
+                            "clean_sheets",
+                            "cards"
+                        ],
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Entries per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Leaderboard"
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}/teams": {
+            "get": {
+                "description": "Get the list of teams registered in a season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "List season teams",
+                "operationId": "list-season-teams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Team"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Enrol an existing team into a season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Register a team in a season",
+                "operationId": "register-season-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team to register",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SeasonTeam"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    }
+                }
+            }
+        },
+        "/seasons/{id}/teams/{team_id}": {
+            "delete": {
+                "description": "Remove a team from a season",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "seasons"
+                ],
+                "summary": "Unregister a team from a season",
+                "operationId": "unregister-season-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "team_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams": {
+            "get": {
+                "description": "Get the list of teams",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List teams",
+                "operationId": "list-teams",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Team"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create a new team",
+                "operationId": "create-team",
+                "parameters": [
+                    {
+                        "description": "Create team",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}": {
+            "get": {
+                "description": "Get an team by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get an team",
+                "operationId": "get-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an team",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update an team",
+                "operationId": "update-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update team",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an team by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete an team",
+                "operationId": "delete-team",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/availability": {
+            "get": {
+                "description": "Get the players of a team sorted into injured, suspended for the next match and fit on a date, today by default",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team availability",
+                "operationId": "get-team-availability",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Date",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamAvailability"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/available-numbers": {
+            "get": {
+                "description": "Get the shirt numbers not yet worn by a player of the team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List available jersey numbers",
+                "operationId": "list-team-available-numbers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/coach-records": {
+            "get": {
+                "description": "Get the win/draw/loss record of the team under each of its head coaches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List team head coach records",
+                "operationId": "list-team-coach-records",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CoachRecord"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/fixtures.ics": {
+            "get": {
+                "description": "Get the past and upcoming matches of a team as an iCalendar (RFC 5545) feed for calendar apps to subscribe to",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team fixtures calendar",
+                "operationId": "get-team-fixtures-calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/form": {
+            "get": {
+                "description": "Get the latest results of a team, most recent first, optionally only at home or away",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team form",
+                "operationId": "get-team-form",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of results",
+                        "name": "last",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "home",
+                            "away"
+                        ],
+                        "type": "string",
+                        "description": "Side",
+                        "name": "side",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Form"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/head-to-head/{other_id}": {
+            "get": {
+                "description": "Get the past meetings between two teams with the aggregated record and biggest win of each side",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get head-to-head record",
+                "operationId": "get-head-to-head",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Opponent team ID",
+                        "name": "other_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Competition ID",
+                        "name": "competition_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "First day",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Last day",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HeadToHead"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/squad": {
+            "get": {
+                "description": "Get the players registered by a team for a season",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List team squad",
+                "operationId": "list-team-squad",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SquadRegistration"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a player of the team in its squad for a season, enforcing the squad rules of the competition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Register a player in a team squad",
+                "operationId": "register-squad-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Register player",
+                        "name": "registration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SquadRegistration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SquadRegistration"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/squad/compliance": {
+            "get": {
+                "description": "Check the squad of a team for a season against the squad rules of the competition and list the violations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team squad compliance",
+                "operationId": "get-squad-compliance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SquadCompliance"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/squad/{player_id}": {
+            "delete": {
+                "description": "Remove a player from the squad of a team for a season",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Unregister a player from a team squad",
+                "operationId": "unregister-squad-player",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "player_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/staff": {
+            "get": {
+                "description": "Get the current and former staff of a team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List team staff",
+                "operationId": "list-team-staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Staff"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a staff member to a team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create a new team staff member",
+                "operationId": "create-team-staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create staff member",
+                        "name": "staff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Staff"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Staff"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/staff/{staff_id}": {
+            "get": {
+                "description": "Get a staff member of a team by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get a team staff member",
+                "operationId": "get-team-staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Staff ID",
+                        "name": "staff_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Staff"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a staff member of a team, e.g. to end their tenure",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update a team staff member",
+                "operationId": "update-team-staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Staff ID",
+                        "name": "staff_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update staff member",
+                        "name": "staff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Staff"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Staff"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a staff member of a team by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete a team staff member",
+                "operationId": "delete-team-staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Staff ID",
+                        "name": "staff_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/stats": {
+            "get": {
+                "description": "Get the aggregated statistics of a team and each of its players",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team statistics",
+                "operationId": "get-team-stats",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season ID",
+                        "name": "season_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamStats"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues": {
+            "get": {
+                "description": "Get the list of venues",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "List venues",
+                "operationId": "list-venues",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Venue"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new venue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Create a new venue",
+                "operationId": "create-venue",
+                "parameters": [
+                    {
+                        "description": "Create venue",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Venue"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Venue"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/{id}": {
+            "get": {
+                "description": "Get a venue by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Get a venue",
+                "operationId": "get-venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Venue"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a venue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Update a venue",
+                "operationId": "update-venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update venue",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Venue"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Venue"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a venue by id",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "Delete a venue",
+                "operationId": "delete-venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/{id}/matches": {
+            "get": {
+                "description": "Get the matches scheduled at a venue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "venues"
+                ],
+                "summary": "List venue matches",
+                "operationId": "list-venue-matches",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Match"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Upgrade to a WebSocket receiving the changes to the followed topics as JSON messages {\"topic\", \"type\", \"data\"}. Send {\"action\": \"subscribe\" or \"unsubscribe\", \"topics\": [\"match:1\", \"team:2\", \"competition:3\"]} to choose the topics; each request is answered with a subscribed, unsubscribed or error message. Besides the message types of the match stream, teams publish team, team_deleted, player and player_deleted messages, and competitions competition and competition_deleted ones.",
+                "tags": [
+                    "live"
+                ],
+                "summary": "Follow live changes over WebSocket",
+                "operationId": "serve-websocket",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
+        "models.Bracket": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
+                "conflicts": {
+                    "description": "Conflicts lists the scheduling conflicts of the first round ties\noverridden when the draw was forced through.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Conflict"
+                    },
+                    "readOnly": true
+                },
+                "final": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.BracketNode"
+                },
+                "rounds": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BracketDraw": {
+            "type": "object",
+            "properties": {
+                "days_between_rounds": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "first_kickoff": {
+                    "type": "string",
+                    "example": "2020-09-01T19:00:00Z"
+                },
+                "team_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.BracketNode": {
+            "type": "object",
+            "properties": {
+                "away_team_id": {
+                    "type": "integer"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BracketNode"
+                    }
+                },
+                "competition_id": {
+                    "type": "integer"
+                },
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kickoff": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "round": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "winner_team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CoachRecord": {
+            "type": "object",
+            "properties": {
+                "drawn": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "lost": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "staff_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Competition": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                }
+            }
+        },
+        "models.Conflict": {
+            "type": "object",
+            "properties": {
+                "match_id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FixtureSchedule": {
+            "type": "object",
+            "properties": {
+                "days_between_rounds": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "double": {
+                    "type": "boolean"
+                },
+                "first_kickoff": {
+                    "type": "string",
+                    "example": "2020-08-15T19:00:00Z"
+                }
+            }
+        },
+        "models.Form": {
+            "type": "object",
+            "properties": {
+                "form": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormResult"
+                    }
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.FormResult": {
+            "type": "object",
+            "properties": {
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "kickoff": {
+                    "type": "string"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "opponent_id": {
+                    "type": "integer"
+                },
+                "opponent_name": {
+                    "type": "string"
+                },
+                "result": {
+                    "type": "string"
+                },
+                "side": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HeadToHead": {
+            "type": "object",
+            "properties": {
+                "biggest_win": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Match"
+                },
+                "drawn": {
+                    "type": "integer"
+                },
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "lost": {
+                    "type": "integer"
+                },
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Match"
+                    }
+                },
+                "opponent_biggest_win": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.Match"
+                },
+                "opponent_id": {
+                    "type": "integer"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Injury": {
+            "type": "object",
+            "properties": {
+                "actual_return": {
+                    "type": "string",
+                    "example": "2020-10-21T00:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "expected_return": {
+                    "type": "string",
+                    "example": "2020-10-24T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-10-03T00:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "hamstring strain"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                }
+            }
+        },
+        "models.Leaderboard": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LeaderboardEntry"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "per_page": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Lineup": {
+            "type": "object",
+            "properties": {
+                "bench": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "formation": {
+                    "type": "string",
+                    "example": "4-3-3"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "starters": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Match": {
+            "type": "object",
+            "properties": {
+                "away_penalties": {
+                    "type": "integer"
+                },
+                "away_score": {
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "away_team_id": {
+                    "type": "integer"
+                },
+                "competition_id": {
+                    "type": "integer"
+                },
+                "conflicts": {
+                    "description": "Conflicts lists the scheduling conflicts overridden when the match\nwas forced through.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Conflict"
+                    },
+                    "readOnly": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "home_penalties": {
+                    "type": "integer"
+                },
+                "home_score": {
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kickoff": {
+                    "type": "string",
+                    "example": "2020-04-21T15:00:00Z"
+                },
+                "next_match_id": {
+                    "description": "NextMatchID is the match of the next round of a bracket the winner of\nthe match goes through to, once it is scheduled.",
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MatchEvent": {
+            "type": "object",
+            "properties": {
+                "added_time": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "match_id": {
+                    "type": "integer"
+                },
+                "minute": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "related_player_id": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                }
+            }
+        },
+        "models.MatchOfficial": {
+            "type": "object",
+            "properties": {
+                "match_id": {
+                    "type": "integer"
+                },
+                "referee_id": {
+                    "type": "integer"
+                },
+                "referee_name": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Player": {
             "type": "object",
             "properties": {
+                "age": {
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "availability": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1995-06-24T00:00:00Z"
+                },
+                "height_cm": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jersey_number": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string",
+                    "example": "ID"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "preferred_foot": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "weight_kg": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerMembership": {
+            "type": "object",
+            "properties": {
+                "from_date": {
+                    "type": "string",
+                    "example": "2020-07-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "to_date": {
+                    "type": "string",
+                    "example": "2021-06-30T00:00:00Z"
+                }
+            }
+        },
+        "models.PlayerStats": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "assists": {
+                    "type": "integer"
+                },
+                "goals": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Referee": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string",
+                    "example": "ID"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                }
+            }
+        },
+        "models.RefereeAssignment": {
+            "type": "object",
+            "properties": {
+                "away_penalties": {
+                    "type": "integer"
+                },
+                "away_score": {
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "away_team_id": {
+                    "type": "integer"
+                },
+                "competition_id": {
+                    "type": "integer"
+                },
+                "conflicts": {
+                    "description": "Conflicts lists the scheduling conflicts overridden when the match\nwas forced through.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Conflict"
+                    },
+                    "readOnly": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "home_penalties": {
+                    "type": "integer"
+                },
+                "home_score": {
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "home_team_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kickoff": {
+                    "type": "string",
+                    "example": "2020-04-21T15:00:00Z"
+                },
+                "next_match_id": {
+                    "description": "NextMatchID is the match of the next round of a bracket the winner of\nthe match goes through to, once it is scheduled.",
+                    "type": "integer",
+                    "readOnly": true
+                },
+                "role": {
+                    "type": "string"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Season": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2020-04-21T00:00:00Z"
                 },
+                "end_date": {
+                    "type": "string",
+                    "example": "2021-05-31T00:00:00Z"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "jersey_number": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "2020/2021"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-08-01T00:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                }
+            }
+        },
+        "models.SeasonTeam": {
+            "type": "object",
+            "properties": {
+                "season_id": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SquadCompliance": {
+            "type": "object",
+            "properties": {
+                "compliant": {
+                    "type": "boolean"
+                },
+                "homegrown": {
+                    "type": "integer"
+                },
+                "non_nationals": {
+                    "type": "integer"
+                },
+                "rules": {
+                    "type": "object",
+                    "$ref": "#/definitions/models.SquadRules"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "squad_size": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.SquadRegistration": {
+            "type": "object",
+            "properties": {
+                "homegrown": {
+                    "type": "boolean"
+                },
+                "nationality": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SquadRules": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
+                "max_non_nationals": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "max_squad_size": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "min_homegrown": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "nationality": {
+                    "type": "string",
+                    "example": "ID"
+                }
+            }
+        },
+        "models.Staff": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2021-06-30T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
+                "role": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-07-01T00:00:00Z"
+                },
                 "team_id": {
                     "type": "integer"
                 },
@@ -391,6 +4081,123 @@ var doc = `{
                 }
             }
         },
+        "models.Standing": {
+            "type": "object",
+            "properties": {
+                "drawn": {
+                    "type": "integer"
+                },
+                "form": {
+                    "type": "string"
+                },
+                "goal_difference": {
+                    "type": "integer"
+                },
+                "goals_against": {
+                    "type": "integer"
+                },
+                "goals_for": {
+                    "type": "integer"
+                },
+                "lost": {
+                    "type": "integer"
+                },
+                "played": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.StandingsRules": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
+                "tiebreakers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "goal_difference",
+                        "goals_scored"
+                    ]
+                }
+            }
+        },
+        "models.Suspension": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
+                "match_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "matches": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "integer"
+                },
+                "served": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "trigger_match_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SuspensionRules": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
+                    "type": "integer"
+                },
+                "red_card_ban": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "yellow_card_ban": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "yellow_card_limit": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
         "models.Team": {
             "type": "object",
             "properties": {
@@ -407,6 +4214,125 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "venue_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TeamAvailability": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "fit": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Player"
+                    }
+                },
+                "injured": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Player"
+                    }
+                },
+                "next_match_id": {
+                    "type": "integer"
+                },
+                "suspended": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Player"
+                    }
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TeamStats": {
+            "type": "object",
+            "properties": {
+                "assists": {
+                    "type": "integer"
+                },
+                "goals": {
+                    "type": "integer"
+                },
+                "matches": {
+                    "type": "integer"
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerStats"
+                    }
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Transfer": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2021-01-31T00:00:00Z"
+                },
+                "jersey_number": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Venue": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "ID"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2020-04-21T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": -6.218
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 106.802
+                },
+                "name": {
+                    "type": "string"
+                },
+                "surface": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string",
                     "example": "2020-04-21T00:00:00Z"
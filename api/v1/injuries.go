@@ -0,0 +1,181 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// List player injuries
+// @Summary List player injuries
+// @Description Get the injury record of a player, latest first
+// @Tags players
+// @ID list-player-injuries
+// @Produce json
+// @Param id path int true "Player ID"
+// @Success 200 {array} models.Injury
+// @Router /players/{id}/injuries [get]
+func (api *API) listPlayerInjuries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	injuries, err := api.injuriesService.ListPlayerInjuries(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, injuries)
+}
+
+// Create a new player injury
+// @Summary Create a new player injury
+// @Description Record an injury of a player
+// @Tags players
+// @ID create-player-injury
+// @Produce json
+// @Param id path int true "Player ID"
+// @Param injury body models.Injury true "Create injury"
+// @Success 201 {object} models.Injury
+// @Router /players/{id}/injuries [post]
+func (api *API) createPlayerInjury(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	injury := new(models.Injury)
+	if err := c.Bind(injury); err != nil {
+		return err
+	}
+
+	if err := c.Validate(injury); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if err := validateInjuryDates(injury); err != nil {
+		return err
+	}
+
+	injury.PlayerID = id
+	newInjury, err := api.injuriesService.CreateInjury(ctx, *injury)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, newInjury)
+}
+
+// Delete a player injury
+// @Summary Delete a player injury
+// @Description Delete an injury of a player by id
+// @Tags players
+// @ID delete-player-injury
+// @Produce plain
+// @Param id path int true "Player ID"
+// @Param injury_id path int true "Injury ID"
+// @Success 204 {string} string ""
+// @Router /players/{id}/injuries/{injury_id} [delete]
+func (api *API) deletePlayerInjury(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	injuryIDString := c.Param("injury_id")
+	injuryID, _ := strconv.ParseInt(injuryIDString, 10, 64)
+
+	if err := api.injuriesService.DeleteInjury(ctx, id, injuryID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a player injury
+// @Summary Update a player injury
+// @Description Update an injury of a player, e.g. to record their return
+// @Tags players
+// @ID update-player-injury
+// @Produce json
+// @Param id path int true "Player ID"
+// @Param injury_id path int true "Injury ID"
+// @Param injury body models.Injury true "Update injury"
+// @Success 201 {object} models.Injury
+// @Router /players/{id}/injuries/{injury_id} [put]
+func (api *API) updatePlayerInjury(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	injuryIDString := c.Param("injury_id")
+	injuryID, _ := strconv.ParseInt(injuryIDString, 10, 64)
+
+	injury := new(models.Injury)
+	if err := c.Bind(injury); err != nil {
+		return err
+	}
+
+	if err := c.Validate(injury); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if err := validateInjuryDates(injury); err != nil {
+		return err
+	}
+
+	injury.ID = injuryID
+	injury.PlayerID = id
+	updatedInjury, err := api.injuriesService.UpdateInjury(ctx, *injury)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, updatedInjury)
+}
+
+// Get team availability
+// @Summary Get team availability
+// @Description Get the players of a team sorted into injured, suspended for the next match and fit on a date, today by default
+// @Tags teams
+// @ID get-team-availability
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param date query string false "Date" format(date)
+// @Success 200 {object} models.TeamAvailability
+// @Router /teams/{id}/availability [get]
+func (api *API) getTeamAvailability(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	date, err := queryDate(c, "date")
+	if err != nil {
+		return err
+	}
+	if date == nil {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		date = &today
+	}
+
+	availability, err := api.injuriesService.GetTeamAvailability(ctx, id, *date)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, availability)
+}
+
+// validateInjuryDates checks that a player does not return before getting
+// injured.
+func validateInjuryDates(injury *models.Injury) error {
+	for _, returnDate := range []*time.Time{injury.ExpectedReturn, injury.ActualReturn} {
+		if returnDate != nil && returnDate.Before(injury.StartDate) {
+			return echo.NewHTTPError(http.StatusBadRequest, "injury must not end before it starts")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_createPlayerInjury(t *testing.T) {
+	injury := models.Injury{
+		Type:      "hamstring strain",
+		StartDate: time.Date(2020, time.October, 3, 0, 0, 0, 0, time.UTC),
+	}
+	injuryJSON, _ := json.Marshal(injury)
+
+	req := httptest.NewRequest(http.MethodPost, "/players/7/injuries", bytes.NewReader(injuryJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/injuries")
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	injury.PlayerID = 7
+	created := injury
+	created.ID = 1
+
+	mockInjuriesService := &mocks.InjuriesService{}
+	mockInjuriesService.On("CreateInjury", mock.Anything, injury).Return(created, nil)
+
+	api := NewAPI(Services{Injuries: mockInjuriesService}, "", "")
+	if assert.NoError(t, api.createPlayerInjury(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"player_id\":7,\"type\":\"hamstring strain\",\"start_date\":\"2020-10-03T00:00:00Z\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createPlayerInjuryInvalidReturn(t *testing.T) {
+	back := time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC)
+	injury := models.Injury{
+		Type:           "hamstring strain",
+		StartDate:      time.Date(2020, time.October, 3, 0, 0, 0, 0, time.UTC),
+		ExpectedReturn: &back,
+	}
+	injuryJSON, _ := json.Marshal(injury)
+
+	req := httptest.NewRequest(http.MethodPost, "/players/7/injuries", bytes.NewReader(injuryJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/injuries")
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	api := NewAPI(Services{Injuries: &mocks.InjuriesService{}}, "", "")
+	err := api.createPlayerInjury(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_getTeamAvailability(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/availability?date=2020-10-10", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/availability")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	date := time.Date(2020, time.October, 10, 0, 0, 0, 0, time.UTC)
+	availability := models.TeamAvailability{
+		TeamID:    1,
+		Date:      date,
+		Injured:   []models.Player{},
+		Suspended: []models.Player{},
+		Fit:       []models.Player{},
+	}
+
+	mockInjuriesService := &mocks.InjuriesService{}
+	mockInjuriesService.On("GetTeamAvailability", mock.Anything, int64(1), date).Return(availability, nil)
+
+	api := NewAPI(Services{Injuries: mockInjuriesService}, "", "")
+	if assert.NoError(t, api.getTeamAvailability(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"team_id\":1,\"date\":\"2020-10-10T00:00:00Z\",\"next_match_id\":null,\"injured\":[],\"suspended\":[],\"fit\":[]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getTeamAvailabilityInvalidDate(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/availability?date=tomorrow", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/availability")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	api := NewAPI(Services{Injuries: &mocks.InjuriesService{}}, "", "")
+	err := api.getTeamAvailability(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// Get a match lineup
+// @Summary Get a match lineup
+// @Description Get the formation, starters and substitutes of a team in a match
+// @Tags matches
+// @ID get-match-lineup
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param team_id path int true "Team ID"
+// @Success 200 {object} models.Lineup
+// @Router /matches/{id}/lineups/{team_id} [get]
+func (api *API) getMatchLineup(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	teamIDString := c.Param("team_id")
+	teamID, _ := strconv.ParseInt(teamIDString, 10, 64)
+
+	lineup, err := api.lineupsService.GetLineup(ctx, id, teamID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, lineup)
+}
+
+// Submit a match lineup
+// @Summary Submit a match lineup
+// @Description Submit the formation, eleven starters with one goalkeeper and the substitutes of a team in a match, replacing any previous lineup; suspended players are rejected
+// @Tags matches
+// @ID save-match-lineup
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param team_id path int true "Team ID"
+// @Param lineup body models.Lineup true "Submit lineup"
+// @Success 201 {object} models.Lineup
+// @Router /matches/{id}/lineups/{team_id} [put]
+func (api *API) saveMatchLineup(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	teamIDString := c.Param("team_id")
+	teamID, _ := strconv.ParseInt(teamIDString, 10, 64)
+
+	lineup := new(models.Lineup)
+	if err := c.Bind(lineup); err != nil {
+		return err
+	}
+
+	if err := c.Validate(lineup); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	lineup.MatchID = id
+	lineup.TeamID = teamID
+	savedLineup, err := api.lineupsService.SaveLineup(ctx, *lineup)
+	if err != nil {
+		return serviceError(err)
+	}
+	if match, ok := api.listenedMatch(c, id); ok {
+		api.hub.Publish(matchTopics(match), live.TypeLineup, savedLineup)
+	}
+
+	return c.JSON(http.StatusCreated, savedLineup)
+}
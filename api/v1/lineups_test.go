@@ -0,0 +1,71 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_getMatchLineup(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches/1/lineups/2", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/lineups/:team_id")
+	c.SetParamNames("id", "team_id")
+	c.SetParamValues("1", "2")
+
+	lineup := models.Lineup{
+		MatchID:   1,
+		TeamID:    2,
+		Formation: "4-4-2",
+		Starters:  []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		Bench:     []int64{12},
+	}
+
+	mockLineupsService := &mocks.LineupsService{}
+	mockLineupsService.On("GetLineup", mock.Anything, int64(1), int64(2)).Return(lineup, nil)
+
+	api := NewAPI(Services{Lineups: mockLineupsService}, "", "")
+	if assert.NoError(t, api.getMatchLineup(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"match_id\":1,\"team_id\":2,\"formation\":\"4-4-2\",\"starters\":[1,2,3,4,5,6,7,8,9,10,11],\"bench\":[12]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_saveMatchLineupInvalid(t *testing.T) {
+	body := `{"formation":"4-4-2","starters":[1,2,3],"bench":[]}`
+	req := httptest.NewRequest(http.MethodPut, "/matches/1/lineups/2", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/lineups/:team_id")
+	c.SetParamNames("id", "team_id")
+	c.SetParamValues("1", "2")
+
+	lineup := models.Lineup{MatchID: 1, TeamID: 2, Formation: "4-4-2", Starters: []int64{1, 2, 3}, Bench: []int64{}}
+
+	mockLineupsService := &mocks.LineupsService{}
+	mockLineupsService.On("SaveLineup", mock.Anything, lineup).
+		Return(models.Lineup{}, services.NewValidationError("lineup must have 11 starters, got 3"))
+
+	api := NewAPI(Services{Lineups: mockLineupsService}, "", "")
+	err := api.saveMatchLineup(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+		assert.Equal(t, []string{"lineup must have 11 starters, got 3"}, err.(*echo.HTTPError).Message)
+	}
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// keepAliveInterval is how often an idle stream sends a comment so that
+// proxies do not drop the connection.
+const keepAliveInterval = 15 * time.Second
+
+// Follow a match live
+// @Summary Follow a match live
+// @Description Stream the changes to a match as Server-Sent Events: the match itself on connection and whenever its score or status changes (match) or it is deleted (match_deleted), the events added to (event_created) or removed from (event_deleted) its timeline, its lineups (lineup) and its officials (official, official_deleted)
+// @Tags matches
+// @ID stream-match
+// @Produce text/event-stream
+// @Param id path int true "Match ID"
+// @Success 200 {string} string ""
+// @Router /matches/{id}/live [get]
+func (api *API) streamMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	sub := api.hub.Subscribe(live.MatchTopic(id))
+	defer sub.Close()
+
+	match, err := api.matchesService.GetMatch(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.WriteHeader(http.StatusOK)
+
+	if err := writeServerSentEvent(res, live.TypeMatch, match); err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return nil
+			}
+			if err := writeServerSentEvent(res, msg.Type, msg.Data); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+			res.Flush()
+		}
+	}
+}
+
+// IsStream tells whether a request opens one of the live streams, the
+// match stream or the WebSocket feed, which are meant to stay open past
+// the write timeout of the server.
+func IsStream(r *http.Request) bool {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	return strings.HasSuffix(path, "/live") || strings.HasSuffix(path, "/ws")
+}
+
+// writeServerSentEvent writes and flushes an event with JSON data.
+func writeServerSentEvent(res *echo.Response, typ string, data interface{}) error {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", typ, dataJSON); err != nil {
+		return err
+	}
+	res.Flush()
+
+	return nil
+}
+
+// matchTopics returns the topics the changes to a match are published on.
+func matchTopics(match models.Match) []string {
+	topics := []string{
+		live.MatchTopic(match.ID),
+		live.TeamTopic(match.HomeTeamID),
+		live.TeamTopic(match.AwayTeamID),
+	}
+	if match.CompetitionID != nil {
+		topics = append(topics, live.CompetitionTopic(*match.CompetitionID))
+	}
+	return topics
+}
+
+// listenedMatch loads a match to publish a change to it on its topics. The
+// match is only loaded when someone is listening, and failing to load it
+// is logged rather than failing a change that has already been made.
+func (api *API) listenedMatch(c echo.Context, matchID int64) (models.Match, bool) {
+	if api.hub.Idle() {
+		return models.Match{}, false
+	}
+
+	match, err := api.matchesService.GetMatch(c.Request().Context(), matchID)
+	if err != nil {
+		c.Logger().Errorf("publish match %d: %s", matchID, err)
+		return models.Match{}, false
+	}
+
+	return match, true
+}
+
+// publishMatchChange publishes a change to the timeline of a match followed
+// by the match itself, whose score it may have changed.
+func (api *API) publishMatchChange(c echo.Context, matchID int64, typ string, data interface{}) {
+	match, ok := api.listenedMatch(c, matchID)
+	if !ok {
+		return
+	}
+
+	topics := matchTopics(match)
+	api.hub.Publish(topics, typ, data)
+	api.hub.Publish(topics, live.TypeMatch, match)
+	api.publishNextMatch(c, match)
+}
+
+// publishNextMatch publishes the next round match of a finished bracket
+// tie, which the result of the tie schedules or corrects.
+func (api *API) publishNextMatch(c echo.Context, match models.Match) {
+	if match.Status != models.MatchFinished || match.NextMatchID == nil {
+		return
+	}
+
+	if next, ok := api.listenedMatch(c, *match.NextMatchID); ok {
+		api.hub.Publish(matchTopics(next), live.TypeMatch, next)
+	}
+}
+
+// playerTeam returns the team of a player about to be changed, so that the
+// team they may leave hears of the change too. The player is only loaded
+// when someone is listening, and nil is returned otherwise or on failure.
+func (api *API) playerTeam(c echo.Context, playerID int64) *int64 {
+	if api.hub.Idle() {
+		return nil
+	}
+
+	player, err := api.playersService.GetPlayer(c.Request().Context(), playerID)
+	if err != nil {
+		c.Logger().Errorf("publish player %d: %s", playerID, err)
+		return nil
+	}
+
+	return &player.TeamID
+}
+
+// publishPlayerChange publishes a player on the topic of their team, and on
+// the topic of the team they left when they changed team.
+func (api *API) publishPlayerChange(player models.Player, previousTeamID *int64) {
+	topics := []string{live.TeamTopic(player.TeamID)}
+	if previousTeamID != nil && *previousTeamID != player.TeamID {
+		topics = append(topics, live.TeamTopic(*previousTeamID))
+	}
+	api.hub.Publish(topics, live.TypePlayer, player)
+}
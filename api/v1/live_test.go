@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_streamMatch(t *testing.T) {
+	match := models.Match{ID: 1, HomeTeamID: 1, AwayTeamID: 2, Status: models.MatchLive}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("GetMatch", mock.Anything, int64(1)).Return(match, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+
+	e := echo.New()
+	e.GET("/matches/:id/live", api.streamMatch)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/matches/1/live")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer res.Body.Close()
+	assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+	stream := bufio.NewReader(res.Body)
+	readEvent := func() string {
+		var event string
+		for {
+			line, err := stream.ReadString('\n')
+			if err != nil || line == "\n" {
+				return event
+			}
+			event += line
+		}
+	}
+
+	assert.Equal(t, "event: match\ndata: {\"id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"0001-01-01T00:00:00Z\",\"status\":\"live\",\"home_score\":0,\"away_score\":0}\n", readEvent())
+
+	api.hub.Publish([]string{live.MatchTopic(2)}, live.TypeMatch, "other match")
+	api.hub.Publish([]string{live.MatchTopic(1)}, live.TypeEventCreated, models.MatchEvent{ID: 5, MatchID: 1, TeamID: 1, PlayerID: 9, Type: models.EventGoal, Minute: 17})
+
+	assert.Equal(t, "event: event_created\ndata: {\"id\":5,\"match_id\":1,\"team_id\":1,\"player_id\":9,\"type\":\"goal\",\"minute\":17,\"added_time\":0}\n", readEvent())
+}
+
+func TestAPI_createMatchEventPublishes(t *testing.T) {
+	event := models.MatchEvent{TeamID: 2, PlayerID: 10, Type: models.EventGoal, Minute: 45}
+	eventJSON, _ := json.Marshal(event)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches/1/events", bytes.NewReader(eventJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/events")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	event.MatchID = 1
+	created := event
+	created.ID = 5
+	competitionID := int64(3)
+	match := models.Match{ID: 1, CompetitionID: &competitionID, HomeTeamID: 1, AwayTeamID: 2, Status: models.MatchLive, AwayScore: 1}
+
+	mockMatchEventsService := &mocks.MatchEventsService{}
+	mockMatchEventsService.On("CreateMatchEvent", mock.Anything, event).Return(created, nil)
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("GetMatch", mock.Anything, int64(1)).Return(match, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService, MatchEvents: mockMatchEventsService}, "", "")
+	sub := api.hub.Subscribe(live.CompetitionTopic(3))
+	defer sub.Close()
+
+	if assert.NoError(t, api.createMatchEvent(c)) {
+		assert.Equal(t, live.Message{Topic: "competition:3", Type: live.TypeEventCreated, Data: created}, <-sub.Messages())
+		assert.Equal(t, live.Message{Topic: "competition:3", Type: live.TypeMatch, Data: match}, <-sub.Messages())
+	}
+}
+
+func TestIsStream(t *testing.T) {
+	for path, stream := range map[string]bool{
+		"/api/v1/matches/3/live":  true,
+		"/api/v1/matches/3/live/": true,
+		"/api/v1/ws":              true,
+		"/api/v1/matches/3":       false,
+		"/api/v1/teams/1/players": false,
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		assert.Equal(t, stream, IsStream(req), path)
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// List match events
+// @Summary List match events
+// @Description Get the timeline of a match
+// @Tags matches
+// @ID list-match-events
+// @Produce json
+// @Param id path int true "Match ID"
+// @Success 200 {array} models.MatchEvent
+// @Router /matches/{id}/events [get]
+func (api *API) listMatchEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	events, err := api.matchEventsService.ListMatchEvents(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, events)
+}
+
+// Create a new match event
+// @Summary Create a new match event
+// @Description Append an event to the timeline of a match, for players of the team they belonged to at kickoff; goal events update the match score
+// @Tags matches
+// @ID create-match-event
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param event body models.MatchEvent true "Create match event"
+// @Success 201 {object} models.MatchEvent
+// @Router /matches/{id}/events [post]
+func (api *API) createMatchEvent(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	event := new(models.MatchEvent)
+	if err := c.Bind(event); err != nil {
+		return err
+	}
+
+	if err := c.Validate(event); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	event.MatchID = id
+	newEvent, err := api.matchEventsService.CreateMatchEvent(ctx, *event)
+	if err != nil {
+		return serviceError(err)
+	}
+	api.publishMatchChange(c, id, live.TypeEventCreated, newEvent)
+
+	return c.JSON(http.StatusCreated, newEvent)
+}
+
+// Delete a match event
+// @Summary Delete a match event
+// @Description Delete an event from the timeline of a match
+// @Tags matches
+// @ID delete-match-event
+// @Produce plain
+// @Param id path int true "Match ID"
+// @Param event_id path int true "Event ID"
+// @Success 204 {string} string ""
+// @Router /matches/{id}/events/{event_id} [delete]
+func (api *API) deleteMatchEvent(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	eventIDString := c.Param("event_id")
+	eventID, _ := strconv.ParseInt(eventIDString, 10, 64)
+
+	if err := api.matchEventsService.DeleteMatchEvent(ctx, id, eventID); err != nil {
+		return serviceError(err)
+	}
+	api.publishMatchChange(c, id, live.TypeEventDeleted, map[string]int64{"id": eventID})
+
+	return c.NoContent(http.StatusNoContent)
+}
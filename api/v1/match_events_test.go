@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listMatchEvents(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches/1/events", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/events")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockMatchEventsService := &mocks.MatchEventsService{}
+	mockMatchEventsService.On("ListMatchEvents", mock.Anything, int64(1)).Return([]models.MatchEvent{}, nil)
+
+	api := NewAPI(Services{MatchEvents: mockMatchEventsService}, "", "")
+	if assert.NoError(t, api.listMatchEvents(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createMatchEvent(t *testing.T) {
+	assist := int64(9)
+	event := models.MatchEvent{
+		TeamID:          2,
+		PlayerID:        10,
+		RelatedPlayerID: &assist,
+		Type:            models.EventGoal,
+		Minute:          45,
+		AddedTime:       2,
+	}
+	eventJSON, _ := json.Marshal(event)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches/1/events", bytes.NewReader(eventJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/events")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	event.MatchID = 1
+	created := event
+	created.ID = 5
+
+	mockMatchEventsService := &mocks.MatchEventsService{}
+	mockMatchEventsService.On("CreateMatchEvent", mock.Anything, event).Return(created, nil)
+
+	api := NewAPI(Services{MatchEvents: mockMatchEventsService}, "", "")
+	if assert.NoError(t, api.createMatchEvent(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":5,\"match_id\":1,\"team_id\":2,\"player_id\":10,\"related_player_id\":9,\"type\":\"goal\",\"minute\":45,\"added_time\":2}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createMatchEventInvalid(t *testing.T) {
+	event := models.MatchEvent{
+		TeamID:   3,
+		PlayerID: 10,
+		Type:     models.EventYellowCard,
+		Minute:   12,
+	}
+	eventJSON, _ := json.Marshal(event)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches/1/events", bytes.NewReader(eventJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/events")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	event.MatchID = 1
+
+	mockMatchEventsService := &mocks.MatchEventsService{}
+	mockMatchEventsService.On("CreateMatchEvent", mock.Anything, event).
+		Return(models.MatchEvent{}, services.NewValidationError("team 3 does not play match 1"))
+
+	api := NewAPI(Services{MatchEvents: mockMatchEventsService}, "", "")
+	err := api.createMatchEvent(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_deleteMatchEvent(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/matches/1/events/5", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/events/:event_id")
+	c.SetParamNames("id", "event_id")
+	c.SetParamValues("1", "5")
+
+	mockMatchEventsService := &mocks.MatchEventsService{}
+	mockMatchEventsService.On("DeleteMatchEvent", mock.Anything, int64(1), int64(5)).Return(nil)
+
+	api := NewAPI(Services{MatchEvents: mockMatchEventsService}, "", "")
+	if assert.NoError(t, api.deleteMatchEvent(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
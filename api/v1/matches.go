@@ -0,0 +1,218 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// List matches
+// @Summary List matches
+// @Description Get the list of matches ordered by kickoff
+// @Tags matches
+// @ID list-matches
+// @Produce json
+// @Param competition_id query int false "Competition ID"
+// @Param season_id query int false "Season ID"
+// @Param team_id query int false "Team ID"
+// @Param venue_id query int false "Venue ID"
+// @Param status query string false "Match status" Enums(scheduled, live, finished, postponed, cancelled)
+// @Success 200 {array} models.Match
+// @Router /matches [get]
+func (api *API) listMatches(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var (
+		filter models.MatchFilter
+		err    error
+	)
+	if filter.CompetitionID, err = queryInt64(c, "competition_id"); err != nil {
+		return err
+	}
+	if filter.SeasonID, err = queryInt64(c, "season_id"); err != nil {
+		return err
+	}
+	if filter.TeamID, err = queryInt64(c, "team_id"); err != nil {
+		return err
+	}
+	if filter.VenueID, err = queryInt64(c, "venue_id"); err != nil {
+		return err
+	}
+	filter.Status = c.QueryParam("status")
+
+	matches, err := api.matchesService.ListMatches(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, matches)
+}
+
+// Get a match
+// @Summary Get a match
+// @Description Get a match by id
+// @Tags matches
+// @ID get-match
+// @Produce json
+// @Param id path int true "Match ID"
+// @Success 200 {object} models.Match
+// @Router /matches/{id} [get]
+func (api *API) getMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	match, err := api.matchesService.GetMatch(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, match)
+}
+
+// Create a new match
+// @Summary Create a new match
+// @Description Create a new match, filed under the competition of its season; scheduling conflicts are rejected with 409 unless forced, in which case they are listed with the match
+// @Tags matches
+// @ID create-match
+// @Produce json
+// @Param match body models.Match true "Create match"
+// @Param force query bool false "Create the match despite scheduling conflicts"
+// @Success 201 {object} models.Match
+// @Router /matches [post]
+func (api *API) createMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	force, err := queryBool(c, "force")
+	if err != nil {
+		return err
+	}
+
+	match := new(models.Match)
+	if err := c.Bind(match); err != nil {
+		return err
+	}
+
+	if err := c.Validate(match); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if match.HomeTeamID == match.AwayTeamID {
+		return echo.NewHTTPError(http.StatusBadRequest, "home and away team must be different")
+	}
+
+	newMatch, err := api.matchesService.CreateMatch(ctx, *match, force)
+	if err != nil {
+		return serviceError(err)
+	}
+	api.hub.Publish(matchTopics(newMatch), live.TypeMatch, newMatch)
+
+	return c.JSON(http.StatusCreated, newMatch)
+}
+
+// Delete a match
+// @Summary Delete a match
+// @Description Delete a match by id
+// @Tags matches
+// @ID delete-match
+// @Produce plain
+// @Param id path int true "Match ID"
+// @Success 204 {string} string ""
+// @Router /matches/{id} [delete]
+func (api *API) deleteMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	match, listened := api.listenedMatch(c, id)
+	if err := api.matchesService.DeleteMatch(ctx, id); err != nil {
+		return err
+	}
+	if listened {
+		api.hub.Publish(matchTopics(match), live.TypeMatchDeleted, map[string]int64{"id": id})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a match
+// @Summary Update a match
+// @Description Update a match; finishing a knockout tie sends its winner, on penalties when level, to the next round, and rescheduling conflicts are rejected with 409 unless forced
+// @Tags matches
+// @ID update-match
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param match body models.Match true "Update match"
+// @Param force query bool false "Reschedule the match despite scheduling conflicts"
+// @Success 201 {object} models.Match
+// @Router /matches/{id} [put]
+func (api *API) updateMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	force, err := queryBool(c, "force")
+	if err != nil {
+		return err
+	}
+
+	match := new(models.Match)
+	if err := c.Bind(match); err != nil {
+		return err
+	}
+
+	if err := c.Validate(match); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if match.HomeTeamID == match.AwayTeamID {
+		return echo.NewHTTPError(http.StatusBadRequest, "home and away team must be different")
+	}
+
+	match.ID = id
+	updatedMatch, err := api.matchesService.UpdateMatch(ctx, *match, force)
+	if err != nil {
+		return serviceError(err)
+	}
+	api.hub.Publish(matchTopics(updatedMatch), live.TypeMatch, updatedMatch)
+	api.publishNextMatch(c, updatedMatch)
+
+	return c.JSON(http.StatusCreated, updatedMatch)
+}
+
+// Validate a match schedule
+// @Summary Validate a match schedule
+// @Description Dry-run the scheduling checks of creating a match, or of rescheduling it when the id is set, and list every conflict found
+// @Tags matches
+// @ID validate-match
+// @Produce json
+// @Param match body models.Match true "Match to validate"
+// @Success 200 {array} models.Conflict
+// @Router /matches/validate [post]
+func (api *API) validateMatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	match := new(models.Match)
+	if err := c.Bind(match); err != nil {
+		return err
+	}
+
+	if err := c.Validate(match); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if match.HomeTeamID == match.AwayTeamID {
+		return echo.NewHTTPError(http.StatusBadRequest, "home and away team must be different")
+	}
+
+	conflicts, err := api.matchesService.ValidateMatch(ctx, *match)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, conflicts)
+}
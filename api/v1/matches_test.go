@@ -0,0 +1,299 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listMatches(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("ListMatches", mock.Anything, models.MatchFilter{}).Return([]models.Match{}, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.listMatches(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getMatch(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	venueID := int64(4)
+	match := models.Match{
+		ID:         1,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+		VenueID:    &venueID,
+		Status:     models.MatchFinished,
+		HomeScore:  2,
+		AwayScore:  1,
+	}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("GetMatch", mock.Anything, int64(1)).Return(match, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.getMatch(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"2020-04-21T15:00:00Z\",\"venue_id\":4,\"status\":\"finished\",\"home_score\":2,\"away_score\":1}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createMatch(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := match
+	created.ID = 1
+	created.Status = models.MatchScheduled
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("CreateMatch", mock.Anything, match, false).Return(created, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.createMatch(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"2020-04-21T15:00:00Z\",\"status\":\"scheduled\",\"home_score\":0,\"away_score\":0}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createMatchSameTeams(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 1,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	mockMatchesService := &mocks.MatchesService{}
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	err := api.createMatch(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+	mockMatchesService.AssertNotCalled(t, "CreateMatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAPI_deleteMatch(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/matches/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("DeleteMatch", mock.Anything, int64(1)).Return(nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.deleteMatch(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "", rec.Body.String())
+	}
+}
+
+func TestAPI_updateMatch(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+		Status:     models.MatchLive,
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPut, "/matches/1", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	updated := match
+	updated.ID = 1
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("UpdateMatch", mock.Anything, updated, false).Return(updated, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.updateMatch(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"2020-04-21T15:00:00Z\",\"status\":\"live\",\"home_score\":0,\"away_score\":0}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_listMatchesFiltered(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches?season_id=3&team_id=7", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	seasonID, teamID := int64(3), int64(7)
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("ListMatches", mock.Anything, models.MatchFilter{SeasonID: &seasonID, TeamID: &teamID}).Return([]models.Match{}, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.listMatches(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_listMatchesInvalidFilter(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/matches?season_id=abc", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	api := NewAPI(Services{Matches: &mocks.MatchesService{}}, "", "")
+	err := api.listMatches(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_createMatchConflicts(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	conflicts := []models.Conflict{{Type: models.ConflictTeamRest, MatchID: 7, Message: "team 1 plays match 7 less than 48h0m0s apart"}}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("CreateMatch", mock.Anything, match, false).
+		Return(models.Match{}, &services.ScheduleConflictError{Conflicts: conflicts})
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	err := api.createMatch(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+		assert.Equal(t, conflicts, err.(*echo.HTTPError).Message)
+	}
+}
+
+func TestAPI_createMatchForced(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches?force=true", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := match
+	created.ID = 1
+	created.Status = models.MatchScheduled
+	created.Conflicts = []models.Conflict{{Type: models.ConflictVenue, MatchID: 7, Message: "venue 4 hosts match 7 on the same day"}}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("CreateMatch", mock.Anything, match, true).Return(created, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.createMatch(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"2020-04-21T15:00:00Z\",\"status\":\"scheduled\",\"home_score\":0,\"away_score\":0,\"conflicts\":[{\"type\":\"venue\",\"match_id\":7,\"message\":\"venue 4 hosts match 7 on the same day\"}]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_validateMatch(t *testing.T) {
+	match := models.Match{
+		ID:         3,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 4, 21, 15, 0, 0, 0, time.UTC),
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPost, "/matches/validate", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	conflicts := []models.Conflict{{Type: models.ConflictOfficial, MatchID: 8, Message: "referee 5 is already assigned to match 8"}}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("ValidateMatch", mock.Anything, match).Return(conflicts, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.validateMatch(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"type\":\"official\",\"match_id\":8,\"message\":\"referee 5 is already assigned to match 8\"}]\n", rec.Body.String())
+	}
+}
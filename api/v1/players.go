@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"soccer/pkg/live"
 	"soccer/pkg/models"
 )
 
@@ -15,12 +16,25 @@ import (
 // @Tags players
 // @ID list-players
 // @Produce json
+// @Param position query string false "Position" Enums(GK, DF, MF, FW)
+// @Param role query string false "Detailed role"
+// @Param nationality query string false "ISO 3166-1 alpha-2 country code"
+// @Param preferred_foot query string false "Preferred foot" Enums(left, right, both)
+// @Param min_age query int false "Minimum age"
+// @Param max_age query int false "Maximum age"
+// @Param min_height query int false "Minimum height in centimeters"
+// @Param max_height query int false "Maximum height in centimeters"
 // @Success 200 {array} models.Player
 // @Router /players [get]
 func (api *API) listPlayers(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	players, err := api.playersService.ListPlayers(ctx)
+	filter, err := playerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	players, err := api.playersService.ListPlayers(ctx, filter)
 	if err != nil {
 		return err
 	}
@@ -34,16 +48,31 @@ func (api *API) listPlayers(c echo.Context) error {
 // @Tags players
 // @ID list-players-team
 // @Produce json
-// @Param team_id path int true "Team ID"
+// @Param id path int true "Team ID"
+// @Param position query string false "Position" Enums(GK, DF, MF, FW)
+// @Param role query string false "Detailed role"
+// @Param nationality query string false "ISO 3166-1 alpha-2 country code"
+// @Param preferred_foot query string false "Preferred foot" Enums(left, right, both)
+// @Param min_age query int false "Minimum age"
+// @Param max_age query int false "Maximum age"
+// @Param min_height query int false "Minimum height in centimeters"
+// @Param max_height query int false "Maximum height in centimeters"
 // @Success 200 {array} models.Player
-// @Router /players/{team_id} [get]
+// @Router /players/{id} [get]
 func (api *API) listPlayersByTeams(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	idString := c.Param("team_id")
+	// The team id shares the path segment of the player id of the other
+	// /players/:id routes, and so its name.
+	idString := c.Param("id")
 	id, _ := strconv.ParseInt(idString, 10, 64)
 
-	players, err := api.playersService.ListPlayersByTeams(ctx, id)
+	filter, err := playerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	players, err := api.playersService.ListPlayersByTeams(ctx, id, filter)
 	if err != nil {
 		return err
 	}
@@ -98,8 +127,9 @@ func (api *API) createPlayer(c echo.Context) error {
 
 	newPlayer, err := api.playersService.CreatePlayer(ctx, *player)
 	if err != nil {
-		return err
+		return serviceError(err)
 	}
+	api.hub.Publish([]string{live.TeamTopic(newPlayer.TeamID)}, live.TypePlayer, newPlayer)
 
 	return c.JSON(http.StatusCreated, newPlayer)
 }
@@ -119,9 +149,13 @@ func (api *API) deletePlayer(c echo.Context) error {
 	idString := c.Param("id")
 	id, _ := strconv.ParseInt(idString, 10, 64)
 
+	teamID := api.playerTeam(c, id)
 	if err := api.playersService.DeletePlayer(ctx, id); err != nil {
 		return err
 	}
+	if teamID != nil {
+		api.hub.Publish([]string{live.TeamTopic(*teamID)}, live.TypePlayerDeleted, map[string]int64{"id": id})
+	}
 
 	return c.String(http.StatusNoContent, "")
 }
@@ -152,10 +186,103 @@ func (api *API) updatePlayer(c echo.Context) error {
 	}
 
 	player.ID = id
+	previousTeamID := api.playerTeam(c, id)
 	updatedPlayer, err := api.playersService.UpdatePlayer(ctx, *player)
 	if err != nil {
-		return err
+		return serviceError(err)
 	}
+	api.publishPlayerChange(updatedPlayer, previousTeamID)
 
 	return c.JSON(http.StatusCreated, updatedPlayer)
 }
+
+// Transfer a player
+// @Summary Transfer a player
+// @Description Move a player to another team, closing the current membership and opening a new one
+// @Tags players
+// @ID transfer-player
+// @Produce json
+// @Param id path int true "Player ID"
+// @Param transfer body models.Transfer true "Transfer"
+// @Success 201 {object} models.PlayerMembership
+// @Router /players/{id}/transfers [post]
+func (api *API) transferPlayer(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	transfer := new(models.Transfer)
+	if err := c.Bind(transfer); err != nil {
+		return err
+	}
+
+	if err := c.Validate(transfer); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	transfer.PlayerID = id
+	previousTeamID := api.playerTeam(c, id)
+	membership, err := api.playersService.TransferPlayer(ctx, *transfer)
+	if err != nil {
+		return serviceError(err)
+	}
+	if previousTeamID != nil {
+		if player, err := api.playersService.GetPlayer(ctx, id); err != nil {
+			c.Logger().Errorf("publish player %d: %s", id, err)
+		} else {
+			api.publishPlayerChange(player, previousTeamID)
+		}
+	}
+
+	return c.JSON(http.StatusCreated, membership)
+}
+
+// Get a player career
+// @Summary Get a player career
+// @Description Get every team a player has been at, oldest first
+// @Tags players
+// @ID get-player-career
+// @Produce json
+// @Param id path int true "Player ID"
+// @Success 200 {array} models.PlayerMembership
+// @Router /players/{id}/career [get]
+func (api *API) getPlayerCareer(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	memberships, err := api.playersService.ListPlayerMemberships(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, memberships)
+}
+
+// playerFilter reads the players list filter from the query parameters.
+func playerFilter(c echo.Context) (models.PlayerFilter, error) {
+	filter := models.PlayerFilter{
+		Position:      c.QueryParam("position"),
+		Role:          c.QueryParam("role"),
+		Nationality:   c.QueryParam("nationality"),
+		PreferredFoot: c.QueryParam("preferred_foot"),
+	}
+
+	var err error
+	if filter.MinAge, err = queryInt(c, "min_age"); err != nil {
+		return models.PlayerFilter{}, err
+	}
+	if filter.MaxAge, err = queryInt(c, "max_age"); err != nil {
+		return models.PlayerFilter{}, err
+	}
+	if filter.MinHeightCm, err = queryInt(c, "min_height"); err != nil {
+		return models.PlayerFilter{}, err
+	}
+	if filter.MaxHeightCm, err = queryInt(c, "max_height"); err != nil {
+		return models.PlayerFilter{}, err
+	}
+
+	return filter, nil
+}
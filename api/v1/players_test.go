@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"soccer/pkg/models"
+	"soccer/pkg/services"
 	"soccer/pkg/services/mocks"
 )
 
@@ -29,15 +31,55 @@ func TestAPI_listPlayers(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	mockPlayersService := &mocks.PlayersService{}
-	mockPlayersService.On("ListPlayers", mock.Anything).Return([]models.Player{}, nil)
+	mockPlayersService.On("ListPlayers", mock.Anything, models.PlayerFilter{}).Return([]models.Player{}, nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.listPlayers(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "[]\n", rec.Body.String())
 	}
 }
 
+func TestAPI_listPlayersFiltered(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/players/1?position=FW&nationality=id&max_age=23", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	maxAge := 23
+	filter := models.PlayerFilter{Position: models.PositionForward, Nationality: "id", MaxAge: &maxAge}
+
+	dateOfBirth := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	age := 20
+	players := []models.Player{{
+		ID:            7,
+		TeamID:        1,
+		Name:          "player-7",
+		JerseyNumber:  "9",
+		Position:      models.PositionForward,
+		Role:          "ST",
+		DateOfBirth:   &dateOfBirth,
+		Age:           &age,
+		Nationality:   "ID",
+		HeightCm:      181,
+		WeightKg:      75,
+		PreferredFoot: "left",
+	}}
+
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("ListPlayersByTeams", mock.Anything, int64(1), filter).Return(players, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	if assert.NoError(t, api.listPlayersByTeams(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"id\":7,\"team_id\":1,\"name\":\"player-7\",\"jersey_number\":\"9\",\"position\":\"FW\",\"role\":\"ST\",\"date_of_birth\":\"2000-02-29T00:00:00Z\",\"age\":20,\"nationality\":\"ID\",\"height_cm\":181,\"weight_kg\":75,\"preferred_foot\":\"left\"}]\n", rec.Body.String())
+	}
+}
+
 func TestAPI_getPlayer(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/players/1", nil)
 	rec := httptest.NewRecorder()
@@ -51,7 +93,7 @@ func TestAPI_getPlayer(t *testing.T) {
 	mockPlayersService := &mocks.PlayersService{}
 	mockPlayersService.On("GetPlayer", mock.Anything, int64(1)).Return(models.Player{}, nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.getPlayer(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "{\"id\":0,\"team_id\":0,\"name\":\"\",\"jersey_number\":\"\"}\n", rec.Body.String())
@@ -73,7 +115,7 @@ func TestAPI_getPlayerTeams(t *testing.T) {
 	mockPlayersService := &mocks.PlayersService{}
 	mockPlayersService.On("GetPlayer", mock.Anything, int64(1)).Return(models.Player{}, nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.getPlayer(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "{\"id\":0,\"team_id\":0,\"name\":\"\",\"jersey_number\":\"\"}\n", rec.Body.String())
@@ -98,13 +140,40 @@ func TestAPI_createPlayer(t *testing.T) {
 	mockPlayersService := &mocks.PlayersService{}
 	mockPlayersService.On("CreatePlayer", mock.Anything, player).Return(player, nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.createPlayer(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		assert.Equal(t, "{\"id\":1,\"team_id\":0,\"name\":\"player-1\",\"jersey_number\":\"10\"}\n", rec.Body.String())
 	}
 }
 
+func TestAPI_createPlayerJerseyNumberTaken(t *testing.T) {
+	player := models.Player{
+		TeamID:       1,
+		Name:         "player-2",
+		JerseyNumber: "10",
+	}
+	playerJSON, _ := json.Marshal(player)
+
+	req := httptest.NewRequest(http.MethodPost, "/players", bytes.NewReader(playerJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("CreatePlayer", mock.Anything, player).
+		Return(models.Player{}, services.NewConflictError("jersey number 10 is already taken in team 1"))
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	err := api.createPlayer(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	}
+}
+
 func TestAPI_deletePlayer(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, "/players/1", nil)
 	rec := httptest.NewRecorder()
@@ -118,7 +187,7 @@ func TestAPI_deletePlayer(t *testing.T) {
 	mockPlayersService := &mocks.PlayersService{}
 	mockPlayersService.On("DeletePlayer", mock.Anything, int64(1)).Return(nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.deletePlayer(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 		assert.Equal(t, "", rec.Body.String())
@@ -143,9 +212,90 @@ func TestAPI_updatePlayer(t *testing.T) {
 	mockPlayersService := &mocks.PlayersService{}
 	mockPlayersService.On("UpdatePlayer", mock.Anything, player).Return(player, nil)
 
-	api := NewAPI(&mocks.TeamsService{}, mockPlayersService, "", "")
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
 	if assert.NoError(t, api.updatePlayer(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		assert.Equal(t, "{\"id\":0,\"team_id\":0,\"name\":\"player-update-1\",\"jersey_number\":\"11\"}\n", rec.Body.String())
 	}
 }
+
+func TestAPI_updatePlayerRoute(t *testing.T) {
+	player := models.Player{Name: "player-update-5", JerseyNumber: "11"}
+	playerJSON, _ := json.Marshal(player)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/players/5", bytes.NewReader(playerJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.SetBasicAuth("admin", "secret")
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+
+	player.ID = 5
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("UpdatePlayer", mock.Anything, player).Return(player, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "admin", "secret")
+	api.Register(e.Group("/api/v1"))
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	mockPlayersService.AssertExpectations(t)
+}
+
+func TestAPI_transferPlayer(t *testing.T) {
+	transfer := models.Transfer{
+		TeamID: 2,
+		Date:   time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	transferJSON, _ := json.Marshal(transfer)
+
+	req := httptest.NewRequest(http.MethodPost, "/players/1/transfers", bytes.NewReader(transferJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/transfers")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	transfer.PlayerID = 1
+	membership := models.PlayerMembership{ID: 3, PlayerID: 1, TeamID: 2, TeamName: "team-2", FromDate: transfer.Date}
+
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("TransferPlayer", mock.Anything, transfer).Return(membership, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	if assert.NoError(t, api.transferPlayer(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":3,\"player_id\":1,\"team_id\":2,\"team_name\":\"team-2\",\"from_date\":\"2021-01-31T00:00:00Z\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getPlayerCareer(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/players/1/career", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/career")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	left := time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)
+	memberships := []models.PlayerMembership{
+		{ID: 1, PlayerID: 1, TeamID: 1, TeamName: "team-1", FromDate: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), ToDate: &left},
+		{ID: 3, PlayerID: 1, TeamID: 2, TeamName: "team-2", FromDate: left},
+	}
+
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("ListPlayerMemberships", mock.Anything, int64(1)).Return(memberships, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	if assert.NoError(t, api.getPlayerCareer(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"id\":1,\"player_id\":1,\"team_id\":1,\"team_name\":\"team-1\",\"from_date\":\"2020-07-01T00:00:00Z\",\"to_date\":\"2021-01-31T00:00:00Z\"},{\"id\":3,\"player_id\":1,\"team_id\":2,\"team_name\":\"team-2\",\"from_date\":\"2021-01-31T00:00:00Z\"}]\n", rec.Body.String())
+	}
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+)
+
+// List referees
+// @Summary List referees
+// @Description Get the list of referees
+// @Tags referees
+// @ID list-referees
+// @Produce json
+// @Success 200 {array} models.Referee
+// @Router /referees [get]
+func (api *API) listReferees(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	referees, err := api.refereesService.ListReferees(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, referees)
+}
+
+// Get a referee
+// @Summary Get a referee
+// @Description Get a referee by id
+// @Tags referees
+// @ID get-referee
+// @Produce json
+// @Param id path int true "Referee ID"
+// @Success 200 {object} models.Referee
+// @Router /referees/{id} [get]
+func (api *API) getReferee(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	referee, err := api.refereesService.GetReferee(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, referee)
+}
+
+// Create a new referee
+// @Summary Create a new referee
+// @Description Create a new referee
+// @Tags referees
+// @ID create-referee
+// @Produce json
+// @Param referee body models.Referee true "Create referee"
+// @Success 201 {object} models.Referee
+// @Router /referees [post]
+func (api *API) createReferee(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	referee := new(models.Referee)
+	if err := c.Bind(referee); err != nil {
+		return err
+	}
+
+	if err := c.Validate(referee); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	newReferee, err := api.refereesService.CreateReferee(ctx, *referee)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, newReferee)
+}
+
+// Delete a referee
+// @Summary Delete a referee
+// @Description Delete a referee by id
+// @Tags referees
+// @ID delete-referee
+// @Produce plain
+// @Param id path int true "Referee ID"
+// @Success 204 {string} string ""
+// @Router /referees/{id} [delete]
+func (api *API) deleteReferee(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	if err := api.refereesService.DeleteReferee(ctx, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a referee
+// @Summary Update a referee
+// @Description Update a referee
+// @Tags referees
+// @ID update-referee
+// @Produce json
+// @Param id path int true "Referee ID"
+// @Param referee body models.Referee true "Update referee"
+// @Success 201 {object} models.Referee
+// @Router /referees/{id} [put]
+func (api *API) updateReferee(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	referee := new(models.Referee)
+	if err := c.Bind(referee); err != nil {
+		return err
+	}
+
+	if err := c.Validate(referee); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	referee.ID = id
+	updatedReferee, err := api.refereesService.UpdateReferee(ctx, *referee)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, updatedReferee)
+}
+
+// List referee matches
+// @Summary List referee matches
+// @Description Get every match a referee has been assigned to, and their role in it
+// @Tags referees
+// @ID list-referee-matches
+// @Produce json
+// @Param id path int true "Referee ID"
+// @Success 200 {array} models.RefereeAssignment
+// @Router /referees/{id}/matches [get]
+func (api *API) listRefereeMatches(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	assignments, err := api.refereesService.ListRefereeAssignments(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, assignments)
+}
+
+// List match officials
+// @Summary List match officials
+// @Description Get the officials assigned to a match
+// @Tags matches
+// @ID list-match-officials
+// @Produce json
+// @Param id path int true "Match ID"
+// @Success 200 {array} models.MatchOfficial
+// @Router /matches/{id}/officials [get]
+func (api *API) listMatchOfficials(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	officials, err := api.refereesService.ListMatchOfficials(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, officials)
+}
+
+// Assign a match official
+// @Summary Assign a match official
+// @Description Assign a referee to a match in the given role, replacing any referee already in that role
+// @Tags matches
+// @ID assign-match-official
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param role path string true "Official role" Enums(referee, assistant_1, assistant_2, fourth_official)
+// @Param official body models.MatchOfficial true "Assign official"
+// @Success 201 {object} models.MatchOfficial
+// @Router /matches/{id}/officials/{role} [put]
+func (api *API) assignMatchOfficial(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	official := new(models.MatchOfficial)
+	if err := c.Bind(official); err != nil {
+		return err
+	}
+
+	official.MatchID = id
+	official.Role = c.Param("role")
+	if err := c.Validate(official); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	assigned, err := api.refereesService.AssignOfficial(ctx, *official)
+	if err != nil {
+		return serviceError(err)
+	}
+	if match, ok := api.listenedMatch(c, id); ok {
+		api.hub.Publish(matchTopics(match), live.TypeOfficial, assigned)
+	}
+
+	return c.JSON(http.StatusCreated, assigned)
+}
+
+// Unassign a match official
+// @Summary Unassign a match official
+// @Description Remove the referee assigned to a match in the given role
+// @Tags matches
+// @ID unassign-match-official
+// @Produce plain
+// @Param id path int true "Match ID"
+// @Param role path string true "Official role" Enums(referee, assistant_1, assistant_2, fourth_official)
+// @Success 204 {string} string ""
+// @Router /matches/{id}/officials/{role} [delete]
+func (api *API) unassignMatchOfficial(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	role := c.Param("role")
+	if err := api.refereesService.UnassignOfficial(ctx, id, role); err != nil {
+		return err
+	}
+	if match, ok := api.listenedMatch(c, id); ok {
+		api.hub.Publish(matchTopics(match), live.TypeOfficialDeleted, map[string]string{"role": role})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listReferees(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/referees", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("ListReferees", mock.Anything).Return([]models.Referee{}, nil)
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	if assert.NoError(t, api.listReferees(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createReferee(t *testing.T) {
+	referee := models.Referee{Name: "Thoriq Alkatiri", Nationality: "ID"}
+	refereeJSON, _ := json.Marshal(referee)
+
+	req := httptest.NewRequest(http.MethodPost, "/referees", bytes.NewReader(refereeJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := referee
+	created.ID = 1
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("CreateReferee", mock.Anything, referee).Return(created, nil)
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	if assert.NoError(t, api.createReferee(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"name\":\"Thoriq Alkatiri\",\"nationality\":\"ID\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_assignMatchOfficial(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/matches/1/officials/referee", bytes.NewReader([]byte(`{"referee_id":2}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/officials/:role")
+	c.SetParamNames("id", "role")
+	c.SetParamValues("1", "referee")
+
+	official := models.MatchOfficial{MatchID: 1, RefereeID: 2, Role: models.OfficialReferee}
+	assigned := official
+	assigned.RefereeName = "Thoriq Alkatiri"
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("AssignOfficial", mock.Anything, official).Return(assigned, nil)
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	if assert.NoError(t, api.assignMatchOfficial(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"match_id\":1,\"referee_id\":2,\"referee_name\":\"Thoriq Alkatiri\",\"role\":\"referee\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_assignMatchOfficialOverlap(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/matches/1/officials/referee", bytes.NewReader([]byte(`{"referee_id":2}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/officials/:role")
+	c.SetParamNames("id", "role")
+	c.SetParamValues("1", "referee")
+
+	official := models.MatchOfficial{MatchID: 1, RefereeID: 2, Role: models.OfficialReferee}
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("AssignOfficial", mock.Anything, official).
+		Return(models.MatchOfficial{}, services.NewConflictError("referee 2 is already assigned to match 3"))
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	err := api.assignMatchOfficial(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_assignMatchOfficialUnknownReferee(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/matches/1/officials/referee", bytes.NewReader([]byte(`{"referee_id":2}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/officials/:role")
+	c.SetParamNames("id", "role")
+	c.SetParamValues("1", "referee")
+
+	official := models.MatchOfficial{MatchID: 1, RefereeID: 2, Role: models.OfficialReferee}
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("AssignOfficial", mock.Anything, official).
+		Return(models.MatchOfficial{}, services.NewValidationError("referee 2 does not exist"))
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	err := api.assignMatchOfficial(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+		assert.Equal(t, []string{"referee 2 does not exist"}, err.(*echo.HTTPError).Message)
+	}
+}
+
+func TestAPI_assignMatchOfficialUnknownMatch(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/matches/9/officials/referee", bytes.NewReader([]byte(`{"referee_id":2}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id/officials/:role")
+	c.SetParamNames("id", "role")
+	c.SetParamValues("9", "referee")
+
+	official := models.MatchOfficial{MatchID: 9, RefereeID: 2, Role: models.OfficialReferee}
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("AssignOfficial", mock.Anything, official).
+		Return(models.MatchOfficial{}, services.NewValidationError("match 9 does not exist"))
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	err := api.assignMatchOfficial(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+		assert.Equal(t, []string{"match 9 does not exist"}, err.(*echo.HTTPError).Message)
+	}
+}
+
+func TestAPI_listRefereeMatches(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/referees/2/matches", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/referees/:id/matches")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	mockRefereesService := &mocks.RefereesService{}
+	mockRefereesService.On("ListRefereeAssignments", mock.Anything, int64(2)).Return([]models.RefereeAssignment{}, nil)
+
+	api := NewAPI(Services{Referees: mockRefereesService}, "", "")
+	if assert.NoError(t, api.listRefereeMatches(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
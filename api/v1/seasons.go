@@ -0,0 +1,251 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get a season
+// @Summary Get a season
+// @Description Get a season by id
+// @Tags seasons
+// @ID get-season
+// @Produce json
+// @Param id path int true "Season ID"
+// @Success 200 {object} models.Season
+// @Router /seasons/{id} [get]
+func (api *API) getSeason(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	season, err := api.seasonsService.GetSeason(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, season)
+}
+
+// Create a new season
+// @Summary Create a new season
+// @Description Create a new season of a competition
+// @Tags seasons
+// @ID create-season
+// @Produce json
+// @Param season body models.Season true "Create season"
+// @Success 201 {object} models.Season
+// @Router /seasons [post]
+func (api *API) createSeason(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	season := new(models.Season)
+	if err := c.Bind(season); err != nil {
+		return err
+	}
+
+	if err := c.Validate(season); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if season.EndDate.Before(season.StartDate) {
+		return echo.NewHTTPError(http.StatusBadRequest, "season must not end before it starts")
+	}
+
+	newSeason, err := api.seasonsService.CreateSeason(ctx, *season)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, newSeason)
+}
+
+// Delete a season
+// @Summary Delete a season
+// @Description Delete a season by id
+// @Tags seasons
+// @ID delete-season
+// @Produce plain
+// @Param id path int true "Season ID"
+// @Success 204 {string} string ""
+// @Router /seasons/{id} [delete]
+func (api *API) deleteSeason(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	if err := api.seasonsService.DeleteSeason(ctx, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a season
+// @Summary Update a season
+// @Description Update a season
+// @Tags seasons
+// @ID update-season
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param season body models.Season true "Update season"
+// @Success 201 {object} models.Season
+// @Router /seasons/{id} [put]
+func (api *API) updateSeason(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	season := new(models.Season)
+	if err := c.Bind(season); err != nil {
+		return err
+	}
+
+	if err := c.Validate(season); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if season.EndDate.Before(season.StartDate) {
+		return echo.NewHTTPError(http.StatusBadRequest, "season must not end before it starts")
+	}
+
+	season.ID = id
+	updatedSeason, err := api.seasonsService.UpdateSeason(ctx, *season)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, updatedSeason)
+}
+
+// List season teams
+// @Summary List season teams
+// @Description Get the list of teams registered in a season
+// @Tags seasons
+// @ID list-season-teams
+// @Produce json
+// @Param id path int true "Season ID"
+// @Success 200 {array} models.Team
+// @Router /seasons/{id}/teams [get]
+func (api *API) listSeasonTeams(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	teams, err := api.seasonsService.ListSeasonTeams(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, teams)
+}
+
+// Register a team in a season
+// @Summary Register a team in a season
+// @Description Enrol an existing team into a season
+// @Tags seasons
+// @ID register-season-team
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param team body models.SeasonTeam true "Team to register"
+// @Success 201 {object} models.Team
+// @Router /seasons/{id}/teams [post]
+func (api *API) registerSeasonTeam(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasonTeam := new(models.SeasonTeam)
+	if err := c.Bind(seasonTeam); err != nil {
+		return err
+	}
+
+	if err := c.Validate(seasonTeam); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	team, err := api.teamsService.GetTeam(ctx, seasonTeam.TeamID)
+	if err != nil {
+		return err
+	}
+
+	seasonTeam.SeasonID = id
+	if err := api.seasonsService.RegisterTeam(ctx, *seasonTeam); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, team)
+}
+
+// Unregister a team from a season
+// @Summary Unregister a team from a season
+// @Description Remove a team from a season
+// @Tags seasons
+// @ID unregister-season-team
+// @Produce plain
+// @Param id path int true "Season ID"
+// @Param team_id path int true "Team ID"
+// @Success 204 {string} string ""
+// @Router /seasons/{id}/teams/{team_id} [delete]
+func (api *API) unregisterSeasonTeam(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	teamIDString := c.Param("team_id")
+	teamID, _ := strconv.ParseInt(teamIDString, 10, 64)
+
+	seasonTeam := models.SeasonTeam{SeasonID: id, TeamID: teamID}
+	if err := api.seasonsService.UnregisterTeam(ctx, seasonTeam); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Generate season fixtures
+// @Summary Generate season fixtures
+// @Description Schedule a single or double round-robin between the teams registered in a season, one round every few days from the first kickoff; scheduling conflicts are rejected with 409 unless forced, in which case they are listed with their matches
+// @Tags seasons
+// @ID generate-season-fixtures
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param schedule body models.FixtureSchedule true "Fixture schedule"
+// @Param force query bool false "Generate the fixtures despite scheduling conflicts"
+// @Success 201 {array} models.Match
+// @Router /seasons/{id}/fixtures [post]
+func (api *API) generateSeasonFixtures(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	force, err := queryBool(c, "force")
+	if err != nil {
+		return err
+	}
+
+	schedule := new(models.FixtureSchedule)
+	if err := c.Bind(schedule); err != nil {
+		return err
+	}
+
+	if err := c.Validate(schedule); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	schedule.SeasonID = id
+	matches, err := api.fixturesService.GenerateFixtures(ctx, *schedule, force)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, matches)
+}
@@ -0,0 +1,251 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_createSeason(t *testing.T) {
+	season := models.Season{
+		CompetitionID: 1,
+		Name:          "2020/2021",
+		StartDate:     time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC),
+	}
+	seasonJSON, _ := json.Marshal(season)
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons", bytes.NewReader(seasonJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := season
+	created.ID = 1
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("CreateSeason", mock.Anything, season).Return(created, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	if assert.NoError(t, api.createSeason(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"competition_id\":1,\"name\":\"2020/2021\",\"start_date\":\"2020-08-01T00:00:00Z\",\"end_date\":\"2021-05-31T00:00:00Z\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createSeasonEndsBeforeStart(t *testing.T) {
+	season := models.Season{
+		CompetitionID: 1,
+		Name:          "2020/2021",
+		StartDate:     time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC),
+	}
+	seasonJSON, _ := json.Marshal(season)
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons", bytes.NewReader(seasonJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	mockSeasonsService := &mocks.SeasonsService{}
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	err := api.createSeason(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+	mockSeasonsService.AssertNotCalled(t, "CreateSeason", mock.Anything, mock.Anything)
+}
+
+func TestAPI_listSeasonTeams(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/seasons/1/teams", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/teams")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("ListSeasonTeams", mock.Anything, int64(1)).Return([]models.Team{{ID: 2, Name: "team-2"}}, nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	if assert.NoError(t, api.listSeasonTeams(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"id\":2,\"name\":\"team-2\",\"description\":\"\"}]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_registerSeasonTeam(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/seasons/1/teams", bytes.NewReader([]byte(`{"team_id":2}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/teams")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockTeamsService := &mocks.TeamsService{}
+	mockTeamsService.On("GetTeam", mock.Anything, int64(2)).Return(models.Team{ID: 2, Name: "team-2"}, nil)
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("RegisterTeam", mock.Anything, models.SeasonTeam{SeasonID: 1, TeamID: 2}).Return(nil)
+
+	api := NewAPI(Services{Teams: mockTeamsService, Seasons: mockSeasonsService}, "", "")
+	if assert.NoError(t, api.registerSeasonTeam(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":2,\"name\":\"team-2\",\"description\":\"\"}\n", rec.Body.String())
+		mockSeasonsService.AssertExpectations(t)
+	}
+}
+
+func TestAPI_unregisterSeasonTeam(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/seasons/1/teams/2", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/teams/:team_id")
+	c.SetParamNames("id", "team_id")
+	c.SetParamValues("1", "2")
+
+	mockSeasonsService := &mocks.SeasonsService{}
+	mockSeasonsService.On("UnregisterTeam", mock.Anything, models.SeasonTeam{SeasonID: 1, TeamID: 2}).Return(nil)
+
+	api := NewAPI(Services{Seasons: mockSeasonsService}, "", "")
+	if assert.NoError(t, api.unregisterSeasonTeam(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
+
+func TestAPI_generateSeasonFixtures(t *testing.T) {
+	kickoff := time.Date(2020, 8, 15, 19, 0, 0, 0, time.UTC)
+	body := `{"double":false,"first_kickoff":"2020-08-15T19:00:00Z","days_between_rounds":7}`
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons/1/fixtures", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/fixtures")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	schedule := models.FixtureSchedule{SeasonID: 1, FirstKickoff: kickoff, DaysBetweenRounds: 7}
+	competitionID, seasonID := int64(1), int64(1)
+	matches := []models.Match{
+		{ID: 1, CompetitionID: &competitionID, SeasonID: &seasonID, HomeTeamID: 1, AwayTeamID: 2, Kickoff: kickoff, Status: models.MatchScheduled},
+	}
+
+	mockFixturesService := &mocks.FixturesService{}
+	mockFixturesService.On("GenerateFixtures", mock.Anything, schedule, false).Return(matches, nil)
+
+	api := NewAPI(Services{Fixtures: mockFixturesService}, "", "")
+	if assert.NoError(t, api.generateSeasonFixtures(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "[{\"id\":1,\"competition_id\":1,\"season_id\":1,\"home_team_id\":1,\"away_team_id\":2,\"kickoff\":\"2020-08-15T19:00:00Z\",\"status\":\"scheduled\",\"home_score\":0,\"away_score\":0}]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_generateSeasonFixturesConflicts(t *testing.T) {
+	body := `{"first_kickoff":"2020-08-15T19:00:00Z","days_between_rounds":1}`
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons/1/fixtures", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/fixtures")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	conflicts := []models.Conflict{
+		{Type: models.ConflictTeamRest, MatchID: 1, Message: "team 1 plays match 1 less than 48h0m0s apart"},
+	}
+	mockFixturesService := &mocks.FixturesService{}
+	mockFixturesService.On("GenerateFixtures", mock.Anything, mock.Anything, false).
+		Return(nil, &services.ScheduleConflictError{Conflicts: conflicts})
+
+	api := NewAPI(Services{Fixtures: mockFixturesService}, "", "")
+	err := api.generateSeasonFixtures(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+		assert.Equal(t, conflicts, err.(*echo.HTTPError).Message)
+	}
+}
+
+func TestAPI_generateSeasonFixturesForced(t *testing.T) {
+	body := `{"first_kickoff":"2020-08-15T19:00:00Z","days_between_rounds":1}`
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons/1/fixtures?force=true", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/fixtures")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	matches := []models.Match{{ID: 2, HomeTeamID: 1, AwayTeamID: 3, Status: models.MatchScheduled, Conflicts: []models.Conflict{
+		{Type: models.ConflictTeamRest, MatchID: 1, Message: "team 1 plays match 1 less than 48h0m0s apart"},
+	}}}
+	mockFixturesService := &mocks.FixturesService{}
+	mockFixturesService.On("GenerateFixtures", mock.Anything, mock.Anything, true).Return(matches, nil)
+
+	api := NewAPI(Services{Fixtures: mockFixturesService}, "", "")
+	if assert.NoError(t, api.generateSeasonFixtures(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"conflicts\":[{\"type\":\"team_rest\",\"match_id\":1")
+	}
+}
+
+func TestAPI_generateSeasonFixturesAlreadyScheduled(t *testing.T) {
+	body := `{"first_kickoff":"2020-08-15T19:00:00Z","days_between_rounds":7}`
+
+	req := httptest.NewRequest(http.MethodPost, "/seasons/1/fixtures", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/fixtures")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockFixturesService := &mocks.FixturesService{}
+	mockFixturesService.On("GenerateFixtures", mock.Anything, mock.Anything, false).
+		Return(nil, services.NewConflictError("season 1 already has 90 matches"))
+
+	api := NewAPI(Services{Fixtures: mockFixturesService}, "", "")
+	err := api.generateSeasonFixtures(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	}
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get competition squad rules
+// @Summary Get competition squad rules
+// @Description Get the rules the season squads of a competition must follow
+// @Tags competitions
+// @ID get-squad-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Success 200 {object} models.SquadRules
+// @Router /competitions/{id}/squad-rules [get]
+func (api *API) getSquadRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules, err := api.squadsService.GetSquadRules(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+// Update competition squad rules
+// @Summary Update competition squad rules
+// @Description Set the rules the season squads of a competition must follow; omitted rules are not enforced
+// @Tags competitions
+// @ID update-squad-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param rules body models.SquadRules true "Update squad rules"
+// @Success 201 {object} models.SquadRules
+// @Router /competitions/{id}/squad-rules [put]
+func (api *API) updateSquadRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules := new(models.SquadRules)
+	if err := c.Bind(rules); err != nil {
+		return err
+	}
+
+	if err := c.Validate(rules); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	rules.CompetitionID = id
+	savedRules, err := api.squadsService.SaveSquadRules(ctx, *rules)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, savedRules)
+}
+
+// List team squad
+// @Summary List team squad
+// @Description Get the players registered by a team for a season
+// @Tags teams
+// @ID list-team-squad
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param season_id query int true "Season ID"
+// @Success 200 {array} models.SquadRegistration
+// @Router /teams/{id}/squad [get]
+func (api *API) listTeamSquad(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasonID, err := requiredSeasonID(c)
+	if err != nil {
+		return err
+	}
+
+	squad, err := api.squadsService.ListSquad(ctx, id, seasonID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, squad)
+}
+
+// Register a player in a team squad
+// @Summary Register a player in a team squad
+// @Description Register a player of the team in its squad for a season, enforcing the squad rules of the competition
+// @Tags teams
+// @ID register-squad-player
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param registration body models.SquadRegistration true "Register player"
+// @Success 201 {object} models.SquadRegistration
+// @Router /teams/{id}/squad [post]
+func (api *API) registerSquadPlayer(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	registration := new(models.SquadRegistration)
+	if err := c.Bind(registration); err != nil {
+		return err
+	}
+
+	if err := c.Validate(registration); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	registration.TeamID = id
+	newRegistration, err := api.squadsService.RegisterPlayer(ctx, *registration)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, newRegistration)
+}
+
+// Unregister a player from a team squad
+// @Summary Unregister a player from a team squad
+// @Description Remove a player from the squad of a team for a season
+// @Tags teams
+// @ID unregister-squad-player
+// @Produce plain
+// @Param id path int true "Team ID"
+// @Param player_id path int true "Player ID"
+// @Param season_id query int true "Season ID"
+// @Success 204 {string} string ""
+// @Router /teams/{id}/squad/{player_id} [delete]
+func (api *API) unregisterSquadPlayer(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	playerIDString := c.Param("player_id")
+	playerID, _ := strconv.ParseInt(playerIDString, 10, 64)
+
+	seasonID, err := requiredSeasonID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := api.squadsService.UnregisterPlayer(ctx, id, seasonID, playerID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Get team squad compliance
+// @Summary Get team squad compliance
+// @Description Check the squad of a team for a season against the squad rules of the competition and list the violations
+// @Tags teams
+// @ID get-squad-compliance
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param season_id query int true "Season ID"
+// @Success 200 {object} models.SquadCompliance
+// @Router /teams/{id}/squad/compliance [get]
+func (api *API) getSquadCompliance(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasonID, err := requiredSeasonID(c)
+	if err != nil {
+		return err
+	}
+
+	compliance, err := api.squadsService.GetSquadCompliance(ctx, id, seasonID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, compliance)
+}
+
+// requiredSeasonID parses the mandatory season_id query parameter.
+func requiredSeasonID(c echo.Context) (int64, error) {
+	seasonID, err := queryInt64(c, "season_id")
+	if err != nil {
+		return 0, err
+	}
+	if seasonID == nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "season_id is required")
+	}
+	return *seasonID, nil
+}
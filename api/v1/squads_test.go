@@ -0,0 +1,89 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_registerSquadPlayerRuleBroken(t *testing.T) {
+	body := `{"season_id":2,"player_id":3,"homegrown":false}`
+	req := httptest.NewRequest(http.MethodPost, "/teams/1/squad", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/squad")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	registration := models.SquadRegistration{SeasonID: 2, TeamID: 1, PlayerID: 3}
+
+	mockSquadsService := &mocks.SquadsService{}
+	mockSquadsService.On("RegisterPlayer", mock.Anything, registration).
+		Return(models.SquadRegistration{}, services.NewValidationError("squad is full with 25 players"))
+
+	api := NewAPI(Services{Squads: mockSquadsService}, "", "")
+	err := api.registerSquadPlayer(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+		assert.Equal(t, []string{"squad is full with 25 players"}, err.(*echo.HTTPError).Message)
+	}
+}
+
+func TestAPI_getSquadCompliance(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/squad/compliance?season_id=2", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/squad/compliance")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	maxSquadSize := 25
+	compliance := models.SquadCompliance{
+		TeamID:     1,
+		SeasonID:   2,
+		Rules:      models.SquadRules{CompetitionID: 3, MaxSquadSize: &maxSquadSize},
+		SquadSize:  26,
+		Violations: []string{"squad has 26 players, more than the maximum of 25"},
+	}
+
+	mockSquadsService := &mocks.SquadsService{}
+	mockSquadsService.On("GetSquadCompliance", mock.Anything, int64(1), int64(2)).Return(compliance, nil)
+
+	api := NewAPI(Services{Squads: mockSquadsService}, "", "")
+	if assert.NoError(t, api.getSquadCompliance(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"team_id\":1,\"season_id\":2,\"rules\":{\"competition_id\":3,\"max_squad_size\":25,\"min_homegrown\":null,\"max_non_nationals\":null,\"nationality\":\"\"},\"squad_size\":26,\"homegrown\":0,\"non_nationals\":0,\"compliant\":false,\"violations\":[\"squad has 26 players, more than the maximum of 25\"]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getSquadComplianceWithoutSeason(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/squad/compliance", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/squad/compliance")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	api := NewAPI(Services{Squads: &mocks.SquadsService{}}, "", "")
+	err := api.getSquadCompliance(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
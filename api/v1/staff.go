@@ -0,0 +1,188 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// List team staff
+// @Summary List team staff
+// @Description Get the current and former staff of a team
+// @Tags teams
+// @ID list-team-staff
+// @Produce json
+// @Param id path int true "Team ID"
+// @Success 200 {array} models.Staff
+// @Router /teams/{id}/staff [get]
+func (api *API) listTeamStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	staff, err := api.staffService.ListStaff(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, staff)
+}
+
+// Get a team staff member
+// @Summary Get a team staff member
+// @Description Get a staff member of a team by id
+// @Tags teams
+// @ID get-team-staff
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param staff_id path int true "Staff ID"
+// @Success 200 {object} models.Staff
+// @Router /teams/{id}/staff/{staff_id} [get]
+func (api *API) getTeamStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	staffIDString := c.Param("staff_id")
+	staffID, _ := strconv.ParseInt(staffIDString, 10, 64)
+
+	staff, err := api.staffService.GetStaff(ctx, id, staffID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, staff)
+}
+
+// Create a new team staff member
+// @Summary Create a new team staff member
+// @Description Add a staff member to a team
+// @Tags teams
+// @ID create-team-staff
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param staff body models.Staff true "Create staff member"
+// @Success 201 {object} models.Staff
+// @Router /teams/{id}/staff [post]
+func (api *API) createTeamStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	staff := new(models.Staff)
+	if err := c.Bind(staff); err != nil {
+		return err
+	}
+
+	if err := c.Validate(staff); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if staff.EndDate != nil && staff.EndDate.Before(staff.StartDate) {
+		return echo.NewHTTPError(http.StatusBadRequest, "tenure must not end before it starts")
+	}
+
+	staff.TeamID = id
+	newStaff, err := api.staffService.CreateStaff(ctx, *staff)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, newStaff)
+}
+
+// Delete a team staff member
+// @Summary Delete a team staff member
+// @Description Delete a staff member of a team by id
+// @Tags teams
+// @ID delete-team-staff
+// @Produce plain
+// @Param id path int true "Team ID"
+// @Param staff_id path int true "Staff ID"
+// @Success 204 {string} string ""
+// @Router /teams/{id}/staff/{staff_id} [delete]
+func (api *API) deleteTeamStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	staffIDString := c.Param("staff_id")
+	staffID, _ := strconv.ParseInt(staffIDString, 10, 64)
+
+	if err := api.staffService.DeleteStaff(ctx, id, staffID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a team staff member
+// @Summary Update a team staff member
+// @Description Update a staff member of a team, e.g. to end their tenure
+// @Tags teams
+// @ID update-team-staff
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param staff_id path int true "Staff ID"
+// @Param staff body models.Staff true "Update staff member"
+// @Success 201 {object} models.Staff
+// @Router /teams/{id}/staff/{staff_id} [put]
+func (api *API) updateTeamStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	staffIDString := c.Param("staff_id")
+	staffID, _ := strconv.ParseInt(staffIDString, 10, 64)
+
+	staff := new(models.Staff)
+	if err := c.Bind(staff); err != nil {
+		return err
+	}
+
+	if err := c.Validate(staff); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	if staff.EndDate != nil && staff.EndDate.Before(staff.StartDate) {
+		return echo.NewHTTPError(http.StatusBadRequest, "tenure must not end before it starts")
+	}
+
+	staff.ID = staffID
+	staff.TeamID = id
+	updatedStaff, err := api.staffService.UpdateStaff(ctx, *staff)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, updatedStaff)
+}
+
+// List team head coach records
+// @Summary List team head coach records
+// @Description Get the win/draw/loss record of the team under each of its head coaches
+// @Tags teams
+// @ID list-team-coach-records
+// @Produce json
+// @Param id path int true "Team ID"
+// @Success 200 {array} models.CoachRecord
+// @Router /teams/{id}/coach-records [get]
+func (api *API) listTeamCoachRecords(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	records, err := api.staffService.ListCoachRecords(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, records)
+}
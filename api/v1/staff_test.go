@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listTeamStaff(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/staff", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/staff")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockStaffService := &mocks.StaffService{}
+	mockStaffService.On("ListStaff", mock.Anything, int64(1)).Return([]models.Staff{}, nil)
+
+	api := NewAPI(Services{Staff: mockStaffService}, "", "")
+	if assert.NoError(t, api.listTeamStaff(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createTeamStaff(t *testing.T) {
+	staff := models.Staff{
+		Name:      "Shin Tae-yong",
+		Role:      models.StaffHeadCoach,
+		StartDate: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	staffJSON, _ := json.Marshal(staff)
+
+	req := httptest.NewRequest(http.MethodPost, "/teams/1/staff", bytes.NewReader(staffJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/staff")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	staff.TeamID = 1
+	created := staff
+	created.ID = 1
+
+	mockStaffService := &mocks.StaffService{}
+	mockStaffService.On("CreateStaff", mock.Anything, staff).Return(created, nil)
+
+	api := NewAPI(Services{Staff: mockStaffService}, "", "")
+	if assert.NoError(t, api.createTeamStaff(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"team_id\":1,\"name\":\"Shin Tae-yong\",\"role\":\"head_coach\",\"start_date\":\"2020-01-01T00:00:00Z\"}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createTeamStaffInvalidTenure(t *testing.T) {
+	end := time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)
+	staff := models.Staff{
+		Name:      "Shin Tae-yong",
+		Role:      models.StaffHeadCoach,
+		StartDate: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   &end,
+	}
+	staffJSON, _ := json.Marshal(staff)
+
+	req := httptest.NewRequest(http.MethodPost, "/teams/1/staff", bytes.NewReader(staffJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/staff")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	api := NewAPI(Services{Staff: &mocks.StaffService{}}, "", "")
+	err := api.createTeamStaff(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_listTeamCoachRecords(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/coach-records", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/coach-records")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	records := []models.CoachRecord{
+		{StaffID: 2, Name: "Shin Tae-yong", StartDate: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), Played: 3, Won: 2, Lost: 1, GoalsFor: 5, GoalsAgainst: 2},
+	}
+
+	mockStaffService := &mocks.StaffService{}
+	mockStaffService.On("ListCoachRecords", mock.Anything, int64(1)).Return(records, nil)
+
+	api := NewAPI(Services{Staff: mockStaffService}, "", "")
+	if assert.NoError(t, api.listTeamCoachRecords(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"staff_id\":2,\"name\":\"Shin Tae-yong\",\"start_date\":\"2020-01-01T00:00:00Z\",\"played\":3,\"won\":2,\"drawn\":0,\"lost\":1,\"goals_for\":5,\"goals_against\":2}]\n", rec.Body.String())
+	}
+}
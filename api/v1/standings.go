@@ -0,0 +1,96 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get competition standings
+// @Summary Get competition standings
+// @Description Get the league table of a competition computed from its finished matches, optionally scoped to a season, with teams level on points ordered by the competition tiebreakers
+// @Tags competitions
+// @ID get-standings
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param season_id query int false "Season ID"
+// @Success 200 {array} models.Standing
+// @Router /competitions/{id}/standings [get]
+func (api *API) getStandings(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	seasonID, err := queryInt64(c, "season_id")
+	if err != nil {
+		return err
+	}
+
+	standings, err := api.standingsService.GetStandings(ctx, id, seasonID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, standings)
+}
+
+// Get competition standings rules
+// @Summary Get competition standings rules
+// @Description Get the ordered tiebreakers a competition applies to teams level on points
+// @Tags competitions
+// @ID get-standings-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Success 200 {object} models.StandingsRules
+// @Router /competitions/{id}/standings-rules [get]
+func (api *API) getStandingsRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules, err := api.standingsService.GetStandingsRules(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+// Update competition standings rules
+// @Summary Update competition standings rules
+// @Description Set the ordered tiebreakers a competition applies to teams level on points, out of goal_difference, goals_scored, head_to_head_points, head_to_head_goal_difference, head_to_head_goals_scored, away_goals, fair_play and drawing_of_lots
+// @Tags competitions
+// @ID update-standings-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param rules body models.StandingsRules true "Update standings rules"
+// @Success 201 {object} models.StandingsRules
+// @Router /competitions/{id}/standings-rules [put]
+func (api *API) updateStandingsRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules := new(models.StandingsRules)
+	if err := c.Bind(rules); err != nil {
+		return err
+	}
+
+	if err := c.Validate(rules); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	rules.CompetitionID = id
+	savedRules, err := api.standingsService.SaveStandingsRules(ctx, *rules)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, savedRules)
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_getStandings(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/1/standings", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/standings")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	standings := []models.Standing{
+		{Position: 1, TeamID: 2, TeamName: "team-2", Played: 1, Won: 1, GoalsFor: 2, GoalDifference: 2, Points: 3, Form: "W"},
+	}
+
+	mockStandingsService := &mocks.StandingsService{}
+	mockStandingsService.On("GetStandings", mock.Anything, int64(1), (*int64)(nil)).Return(standings, nil)
+
+	api := NewAPI(Services{Standings: mockStandingsService}, "", "")
+	if assert.NoError(t, api.getStandings(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"position\":1,\"team_id\":2,\"team_name\":\"team-2\",\"played\":1,\"won\":1,\"drawn\":0,\"lost\":0,\"goals_for\":2,\"goals_against\":0,\"goal_difference\":2,\"points\":3,\"form\":\"W\"}]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getStandingsRules(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/3/standings-rules", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/standings-rules")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	rules := models.StandingsRules{CompetitionID: 3, Tiebreakers: []string{"goal_difference", "goals_scored"}}
+
+	mockStandingsService := &mocks.StandingsService{}
+	mockStandingsService.On("GetStandingsRules", mock.Anything, int64(3)).Return(rules, nil)
+
+	api := NewAPI(Services{Standings: mockStandingsService}, "", "")
+	if assert.NoError(t, api.getStandingsRules(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"competition_id\":3,\"tiebreakers\":[\"goal_difference\",\"goals_scored\"]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_updateStandingsRules(t *testing.T) {
+	body := `{"tiebreakers":["head_to_head_points","head_to_head_goal_difference","goal_difference"]}`
+	req := httptest.NewRequest(http.MethodPut, "/competitions/3/standings-rules", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/standings-rules")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	rules := models.StandingsRules{
+		CompetitionID: 3,
+		Tiebreakers:   []string{"head_to_head_points", "head_to_head_goal_difference", "goal_difference"},
+	}
+
+	mockStandingsService := &mocks.StandingsService{}
+	mockStandingsService.On("SaveStandingsRules", mock.Anything, rules).Return(rules, nil)
+
+	api := NewAPI(Services{Standings: mockStandingsService}, "", "")
+	if assert.NoError(t, api.updateStandingsRules(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"competition_id\":3,\"tiebreakers\":[\"head_to_head_points\",\"head_to_head_goal_difference\",\"goal_difference\"]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_updateStandingsRulesUnknownTiebreaker(t *testing.T) {
+	body := `{"tiebreakers":["coin_toss"]}`
+	req := httptest.NewRequest(http.MethodPut, "/competitions/3/standings-rules", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/standings-rules")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	rules := models.StandingsRules{CompetitionID: 3, Tiebreakers: []string{"coin_toss"}}
+
+	mockStandingsService := &mocks.StandingsService{}
+	mockStandingsService.On("SaveStandingsRules", mock.Anything, rules).
+		Return(models.StandingsRules{}, services.NewValidationError(`unknown tiebreaker "coin_toss"`))
+
+	api := NewAPI(Services{Standings: mockStandingsService}, "", "")
+	err := api.updateStandingsRules(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
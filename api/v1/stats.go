@@ -0,0 +1,204 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get player statistics
+// @Summary Get player statistics
+// @Description Get appearances, minutes, goals, assists and cards of a player
+// @Tags players
+// @ID get-player-stats
+// @Produce json
+// @Param id path int true "Player ID"
+// @Param season_id query int false "Season ID"
+// @Success 200 {object} models.PlayerStats
+// @Router /players/{id}/stats [get]
+func (api *API) getPlayerStats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	var (
+		filter models.StatsFilter
+		err    error
+	)
+	if filter.SeasonID, err = queryInt64(c, "season_id"); err != nil {
+		return err
+	}
+
+	stats, err := api.statsService.GetPlayerStats(ctx, id, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// Get team statistics
+// @Summary Get team statistics
+// @Description Get the aggregated statistics of a team and each of its players
+// @Tags teams
+// @ID get-team-stats
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param season_id query int false "Season ID"
+// @Success 200 {object} models.TeamStats
+// @Router /teams/{id}/stats [get]
+func (api *API) getTeamStats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	var (
+		filter models.StatsFilter
+		err    error
+	)
+	if filter.SeasonID, err = queryInt64(c, "season_id"); err != nil {
+		return err
+	}
+
+	stats, err := api.statsService.GetTeamStats(ctx, id, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// Get head-to-head record
+// @Summary Get head-to-head record
+// @Description Get the past meetings between two teams with the aggregated record and biggest win of each side
+// @Tags teams
+// @ID get-head-to-head
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param other_id path int true "Opponent team ID"
+// @Param competition_id query int false "Competition ID"
+// @Param from query string false "First day" format(date)
+// @Param to query string false "Last day" format(date)
+// @Success 200 {object} models.HeadToHead
+// @Router /teams/{id}/head-to-head/{other_id} [get]
+func (api *API) getHeadToHead(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+	otherIDString := c.Param("other_id")
+	otherID, _ := strconv.ParseInt(otherIDString, 10, 64)
+
+	if id == otherID {
+		return echo.NewHTTPError(http.StatusBadRequest, "teams must be different")
+	}
+
+	var (
+		filter models.HeadToHeadFilter
+		err    error
+	)
+	if filter.CompetitionID, err = queryInt64(c, "competition_id"); err != nil {
+		return err
+	}
+	if filter.From, err = queryDate(c, "from"); err != nil {
+		return err
+	}
+	if filter.To, err = queryDate(c, "to"); err != nil {
+		return err
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return echo.NewHTTPError(http.StatusBadRequest, "to must not be before from")
+	}
+
+	h2h, err := api.statsService.GetHeadToHead(ctx, id, otherID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, h2h)
+}
+
+// Get team form
+// @Summary Get team form
+// @Description Get the latest results of a team, most recent first, optionally only at home or away
+// @Tags teams
+// @ID get-team-form
+// @Produce json
+// @Param id path int true "Team ID"
+// @Param last query int false "Number of results" default(5)
+// @Param side query string false "Side" Enums(home, away)
+// @Success 200 {object} models.Form
+// @Router /teams/{id}/form [get]
+func (api *API) getTeamForm(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	last, err := queryInt(c, "last")
+	if err != nil {
+		return err
+	}
+
+	filter := models.FormFilter{Side: c.QueryParam("side")}
+	if last != nil {
+		if *last < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "last must be positive")
+		}
+		filter.Last = *last
+	}
+	if filter.Side != "" && filter.Side != models.SideHome && filter.Side != models.SideAway {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid side: %s", filter.Side))
+	}
+
+	form, err := api.statsService.GetTeamForm(ctx, id, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, form)
+}
+
+// Get season leaderboard
+// @Summary Get season leaderboard
+// @Description Get a page of the players of a season ranked by goals, assists, clean sheets or cards, ties going to fewer minutes played
+// @Tags seasons
+// @ID get-season-leaderboard
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param category path string true "Category" Enums(goals, assists, clean_sheets, cards)
+// @Param page query int false "Page" default(1)
+// @Param per_page query int false "Entries per page" default(20)
+// @Success 200 {object} models.Leaderboard
+// @Router /seasons/{id}/leaderboards/{category} [get]
+func (api *API) getSeasonLeaderboard(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	category := c.Param("category")
+	switch category {
+	case models.LeaderboardGoals, models.LeaderboardAssists, models.LeaderboardCleanSheets, models.LeaderboardCards:
+	default:
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown leaderboard: %s", category))
+	}
+
+	page, err := queryPagination(c)
+	if err != nil {
+		return err
+	}
+
+	leaderboard, err := api.statsService.GetLeaderboard(ctx, id, category, page)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, leaderboard)
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_getPlayerStats(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/players/1/stats?season_id=2", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/stats")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	seasonID := int64(2)
+	stats := models.PlayerStats{PlayerID: 1, Appearances: 3, Minutes: 250, Goals: 2, Assists: 1, YellowCards: 1}
+
+	mockStatsService := &mocks.StatsService{}
+	mockStatsService.On("GetPlayerStats", mock.Anything, int64(1), models.StatsFilter{SeasonID: &seasonID}).Return(stats, nil)
+
+	api := NewAPI(Services{Stats: mockStatsService}, "", "")
+	if assert.NoError(t, api.getPlayerStats(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"player_id\":1,\"appearances\":3,\"minutes\":250,\"goals\":2,\"assists\":1,\"yellow_cards\":1,\"red_cards\":0}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getTeamStats(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/stats", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/stats")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	stats := models.TeamStats{TeamID: 1, Matches: 2, Players: []models.PlayerStats{}}
+
+	mockStatsService := &mocks.StatsService{}
+	mockStatsService.On("GetTeamStats", mock.Anything, int64(1), models.StatsFilter{}).Return(stats, nil)
+
+	api := NewAPI(Services{Stats: mockStatsService}, "", "")
+	if assert.NoError(t, api.getTeamStats(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"team_id\":1,\"matches\":2,\"goals\":0,\"assists\":0,\"yellow_cards\":0,\"red_cards\":0,\"players\":[]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getHeadToHead(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/head-to-head/2?competition_id=3&from=2019-01-01&to=2019-12-31", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/head-to-head/:other_id")
+	c.SetParamNames("id", "other_id")
+	c.SetParamValues("1", "2")
+
+	competitionID := int64(3)
+	from := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)
+	filter := models.HeadToHeadFilter{CompetitionID: &competitionID, From: &from, To: &to}
+	h2h := models.HeadToHead{TeamID: 1, OpponentID: 2, Matches: []models.Match{}}
+
+	mockStatsService := &mocks.StatsService{}
+	mockStatsService.On("GetHeadToHead", mock.Anything, int64(1), int64(2), filter).Return(h2h, nil)
+
+	api := NewAPI(Services{Stats: mockStatsService}, "", "")
+	if assert.NoError(t, api.getHeadToHead(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"team_id\":1,\"opponent_id\":2,\"played\":0,\"won\":0,\"drawn\":0,\"lost\":0,\"goals_for\":0,\"goals_against\":0,\"biggest_win\":null,\"opponent_biggest_win\":null,\"matches\":[]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getHeadToHeadSameTeam(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/head-to-head/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/head-to-head/:other_id")
+	c.SetParamNames("id", "other_id")
+	c.SetParamValues("1", "1")
+
+	api := NewAPI(Services{Stats: &mocks.StatsService{}}, "", "")
+	err := api.getHeadToHead(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_getTeamForm(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/form?last=1&side=home", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/form")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	form := models.Form{
+		TeamID: 1,
+		Form:   "W",
+		Results: []models.FormResult{{
+			MatchID:      9,
+			Kickoff:      time.Date(2020, time.March, 7, 15, 0, 0, 0, time.UTC),
+			Side:         models.SideHome,
+			OpponentID:   2,
+			OpponentName: "team-2",
+			GoalsFor:     2,
+			GoalsAgainst: 1,
+			Result:       models.ResultWin,
+		}},
+	}
+
+	mockStatsService := &mocks.StatsService{}
+	mockStatsService.On("GetTeamForm", mock.Anything, int64(1), models.FormFilter{Last: 1, Side: models.SideHome}).Return(form, nil)
+
+	api := NewAPI(Services{Stats: mockStatsService}, "", "")
+	if assert.NoError(t, api.getTeamForm(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"team_id\":1,\"form\":\"W\",\"results\":[{\"match_id\":9,\"kickoff\":\"2020-03-07T15:00:00Z\",\"side\":\"home\",\"opponent_id\":2,\"opponent_name\":\"team-2\",\"goals_for\":2,\"goals_against\":1,\"result\":\"W\"}]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getTeamFormInvalidSide(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/form?side=neutral", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/form")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	api := NewAPI(Services{Stats: &mocks.StatsService{}}, "", "")
+	err := api.getTeamForm(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_getSeasonLeaderboard(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/seasons/2/leaderboards/goals?page=2&per_page=1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/leaderboards/:category")
+	c.SetParamNames("id", "category")
+	c.SetParamValues("2", "goals")
+
+	leaderboard := models.Leaderboard{
+		SeasonID: 2,
+		Category: models.LeaderboardGoals,
+		Page:     2,
+		PerPage:  1,
+		Total:    2,
+		Entries: []models.LeaderboardEntry{
+			{Rank: 2, PlayerID: 7, PlayerName: "player-7", TeamID: 1, TeamName: "team-1", Value: 3, Appearances: 4, Minutes: 300},
+		},
+	}
+
+	mockStatsService := &mocks.StatsService{}
+	mockStatsService.On("GetLeaderboard", mock.Anything, int64(2), models.LeaderboardGoals, models.Pagination{Page: 2, PerPage: 1}).
+		Return(leaderboard, nil)
+
+	api := NewAPI(Services{Stats: mockStatsService}, "", "")
+	if assert.NoError(t, api.getSeasonLeaderboard(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"season_id\":2,\"category\":\"goals\",\"page\":2,\"per_page\":1,\"total\":2,\"entries\":[{\"rank\":2,\"player_id\":7,\"player_name\":\"player-7\",\"team_id\":1,\"team_name\":\"team-1\",\"value\":3,\"appearances\":4,\"minutes\":300}]}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getSeasonLeaderboardInvalidPage(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/seasons/2/leaderboards/goals?per_page=1000", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/seasons/:id/leaderboards/:category")
+	c.SetParamNames("id", "category")
+	c.SetParamValues("2", "goals")
+
+	api := NewAPI(Services{Stats: &mocks.StatsService{}}, "", "")
+	err := api.getSeasonLeaderboard(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
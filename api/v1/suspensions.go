@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// Get competition suspension rules
+// @Summary Get competition suspension rules
+// @Description Get how many matches red cards and accumulated yellow cards ban players for in a competition
+// @Tags competitions
+// @ID get-suspension-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Success 200 {object} models.SuspensionRules
+// @Router /competitions/{id}/suspension-rules [get]
+func (api *API) getSuspensionRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules, err := api.suspensionsService.GetSuspensionRules(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+// Update competition suspension rules
+// @Summary Update competition suspension rules
+// @Description Set how many matches red cards and accumulated yellow cards ban players for in a competition
+// @Tags competitions
+// @ID update-suspension-rules
+// @Produce json
+// @Param id path int true "Competition ID"
+// @Param rules body models.SuspensionRules true "Update suspension rules"
+// @Success 201 {object} models.SuspensionRules
+// @Router /competitions/{id}/suspension-rules [put]
+func (api *API) updateSuspensionRules(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	rules := new(models.SuspensionRules)
+	if err := c.Bind(rules); err != nil {
+		return err
+	}
+
+	if err := c.Validate(rules); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	rules.CompetitionID = id
+	savedRules, err := api.suspensionsService.SaveSuspensionRules(ctx, *rules)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, savedRules)
+}
+
+// List player suspensions
+// @Summary List player suspensions
+// @Description Get the bans a player picked up from cards in each season of a competition, with the matches they cover and how many remain to be served
+// @Tags players
+// @ID list-player-suspensions
+// @Produce json
+// @Param id path int true "Player ID"
+// @Success 200 {array} models.Suspension
+// @Router /players/{id}/suspensions [get]
+func (api *API) listPlayerSuspensions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	suspensions, err := api.suspensionsService.ListPlayerSuspensions(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, suspensions)
+}
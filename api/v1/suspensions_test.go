@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listPlayerSuspensions(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/players/7/suspensions", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/suspensions")
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	suspensions := []models.Suspension{
+		{PlayerID: 7, TeamID: 1, CompetitionID: 3, Reason: models.SuspensionRedCard, TriggerMatchID: 11,
+			Matches: 2, MatchIDs: []int64{13, 14}, Served: 1, Remaining: 1},
+	}
+
+	mockSuspensionsService := &mocks.SuspensionsService{}
+	mockSuspensionsService.On("ListPlayerSuspensions", mock.Anything, int64(7)).Return(suspensions, nil)
+
+	api := NewAPI(Services{Suspensions: mockSuspensionsService}, "", "")
+	if assert.NoError(t, api.listPlayerSuspensions(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[{\"player_id\":7,\"team_id\":1,\"competition_id\":3,\"reason\":\"red_card\",\"trigger_match_id\":11,\"matches\":2,\"match_ids\":[13,14],\"served\":1,\"remaining\":1}]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_getSuspensionRules(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/competitions/3/suspension-rules", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/competitions/:id/suspension-rules")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	rules := models.SuspensionRules{CompetitionID: 3, RedCardBan: 1, YellowCardLimit: 5, YellowCardBan: 1}
+
+	mockSuspensionsService := &mocks.SuspensionsService{}
+	mockSuspensionsService.On("GetSuspensionRules", mock.Anything, int64(3)).Return(rules, nil)
+
+	api := NewAPI(Services{Suspensions: mockSuspensionsService}, "", "")
+	if assert.NoError(t, api.getSuspensionRules(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{\"competition_id\":3,\"red_card_ban\":1,\"yellow_card_limit\":5,\"yellow_card_ban\":1}\n", rec.Body.String())
+	}
+}
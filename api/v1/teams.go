@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"soccer/pkg/live"
 	"soccer/pkg/models"
 )
 
@@ -76,6 +77,7 @@ func (api *API) createTeam(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	api.hub.Publish([]string{live.TeamTopic(newTeam.ID)}, live.TypeTeam, newTeam)
 
 	return c.JSON(http.StatusCreated, newTeam)
 }
@@ -98,6 +100,7 @@ func (api *API) deleteTeam(c echo.Context) error {
 	if err := api.teamsService.DeleteTeam(ctx, id); err != nil {
 		return err
 	}
+	api.hub.Publish([]string{live.TeamTopic(id)}, live.TypeTeamDeleted, map[string]int64{"id": id})
 
 	return c.String(http.StatusNoContent, "")
 }
@@ -132,6 +135,30 @@ func (api *API) updateTeam(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	api.hub.Publish([]string{live.TeamTopic(updatedTeam.ID)}, live.TypeTeam, updatedTeam)
 
 	return c.JSON(http.StatusCreated, updatedTeam)
 }
+
+// List available jersey numbers
+// @Summary List available jersey numbers
+// @Description Get the shirt numbers not yet worn by a player of the team
+// @Tags teams
+// @ID list-team-available-numbers
+// @Produce json
+// @Param id path int true "Team ID"
+// @Success 200 {array} integer
+// @Router /teams/{id}/available-numbers [get]
+func (api *API) listTeamAvailableNumbers(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	numbers, err := api.playersService.ListAvailableJerseyNumbers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, numbers)
+}
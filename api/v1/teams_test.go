@@ -31,7 +31,7 @@ func TestAPI_listTeams(t *testing.T) {
 	mockTeamsService := &mocks.TeamsService{}
 	mockTeamsService.On("ListTeams", mock.Anything).Return([]models.Team{}, nil)
 
-	api := NewAPI(mockTeamsService, &mocks.PlayersService{}, "", "")
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
 	if assert.NoError(t, api.listTeams(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "[]\n", rec.Body.String())
@@ -51,7 +51,7 @@ func TestAPI_getTeam(t *testing.T) {
 	mockTeamsService := &mocks.TeamsService{}
 	mockTeamsService.On("GetTeam", mock.Anything, int64(1)).Return(models.Team{}, nil)
 
-	api := NewAPI(mockTeamsService, &mocks.PlayersService{}, "", "")
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
 	if assert.NoError(t, api.getTeam(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "{\"id\":0,\"name\":\"\",\"description\":\"\"}\n", rec.Body.String())
@@ -77,7 +77,7 @@ func TestAPI_createTeam(t *testing.T) {
 	mockTeamsService := &mocks.TeamsService{}
 	mockTeamsService.On("CreateTeam", mock.Anything, team).Return(team, nil)
 
-	api := NewAPI(mockTeamsService, &mocks.PlayersService{}, "", "")
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
 	if assert.NoError(t, api.createTeam(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		assert.Equal(t, "{\"id\":1,\"name\":\"team-1\",\"description\":\"this is Description\"}\n", rec.Body.String())
@@ -97,7 +97,7 @@ func TestAPI_deleteTeam(t *testing.T) {
 	mockTeamsService := &mocks.TeamsService{}
 	mockTeamsService.On("DeleteTeam", mock.Anything, int64(1)).Return(nil)
 
-	api := NewAPI(mockTeamsService, &mocks.PlayersService{}, "", "")
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
 	if assert.NoError(t, api.deleteTeam(c)) {
 		assert.Equal(t, http.StatusNoContent, rec.Code)
 		assert.Equal(t, "", rec.Body.String())
@@ -122,9 +122,29 @@ func TestAPI_updateTeam(t *testing.T) {
 	mockTeamsService := &mocks.TeamsService{}
 	mockTeamsService.On("UpdateTeam", mock.Anything, team).Return(team, nil)
 
-	api := NewAPI(mockTeamsService, &mocks.PlayersService{}, "", "")
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
 	if assert.NoError(t, api.updateTeam(c)) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 		assert.Equal(t, "{\"id\":0,\"name\":\"team-update-1\",\"description\":\"Description\"}\n", rec.Body.String())
 	}
 }
+
+func TestAPI_listTeamAvailableNumbers(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/teams/1/available-numbers", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/teams/:id/available-numbers")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("ListAvailableJerseyNumbers", mock.Anything, int64(1)).Return([]int{2, 5, 99}, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	if assert.NoError(t, api.listTeamAvailableNumbers(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[2,5,99]\n", rec.Body.String())
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/models"
+)
+
+// List venues
+// @Summary List venues
+// @Description Get the list of venues
+// @Tags venues
+// @ID list-venues
+// @Produce json
+// @Success 200 {array} models.Venue
+// @Router /venues [get]
+func (api *API) listVenues(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	venues, err := api.venuesService.ListVenues(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, venues)
+}
+
+// Get a venue
+// @Summary Get a venue
+// @Description Get a venue by id
+// @Tags venues
+// @ID get-venue
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Success 200 {object} models.Venue
+// @Router /venues/{id} [get]
+func (api *API) getVenue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	venue, err := api.venuesService.GetVenue(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, venue)
+}
+
+// Create a new venue
+// @Summary Create a new venue
+// @Description Create a new venue
+// @Tags venues
+// @ID create-venue
+// @Produce json
+// @Param venue body models.Venue true "Create venue"
+// @Success 201 {object} models.Venue
+// @Router /venues [post]
+func (api *API) createVenue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	venue := new(models.Venue)
+	if err := c.Bind(venue); err != nil {
+		return err
+	}
+
+	if err := c.Validate(venue); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	newVenue, err := api.venuesService.CreateVenue(ctx, *venue)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, newVenue)
+}
+
+// Delete a venue
+// @Summary Delete a venue
+// @Description Delete a venue by id
+// @Tags venues
+// @ID delete-venue
+// @Produce plain
+// @Param id path int true "Venue ID"
+// @Success 204 {string} string ""
+// @Router /venues/{id} [delete]
+func (api *API) deleteVenue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	if err := api.venuesService.DeleteVenue(ctx, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Update a venue
+// @Summary Update a venue
+// @Description Update a venue
+// @Tags venues
+// @ID update-venue
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Param venue body models.Venue true "Update venue"
+// @Success 201 {object} models.Venue
+// @Router /venues/{id} [put]
+func (api *API) updateVenue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	venue := new(models.Venue)
+	if err := c.Bind(venue); err != nil {
+		return err
+	}
+
+	if err := c.Validate(venue); err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	venue.ID = id
+	updatedVenue, err := api.venuesService.UpdateVenue(ctx, *venue)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, updatedVenue)
+}
+
+// List venue matches
+// @Summary List venue matches
+// @Description Get the matches scheduled at a venue
+// @Tags venues
+// @ID list-venue-matches
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Success 200 {array} models.Match
+// @Router /venues/{id}/matches [get]
+func (api *API) listVenueMatches(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	idString := c.Param("id")
+	id, _ := strconv.ParseInt(idString, 10, 64)
+
+	filter := models.MatchFilter{VenueID: &id, Status: models.MatchScheduled}
+	matches, err := api.matchesService.ListMatches(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, matches)
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/models"
+	"soccer/pkg/services"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_listVenues(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/venues", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+
+	mockVenuesService := &mocks.VenuesService{}
+	mockVenuesService.On("ListVenues", mock.Anything).Return([]models.Venue{}, nil)
+
+	api := NewAPI(Services{Venues: mockVenuesService}, "", "")
+	if assert.NoError(t, api.listVenues(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createVenue(t *testing.T) {
+	latitude, longitude := -6.218, 106.802
+	venue := models.Venue{
+		Name:      "Gelora Bung Karno",
+		City:      "Jakarta",
+		Country:   "ID",
+		Capacity:  77193,
+		Surface:   "grass",
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}
+	venueJSON, _ := json.Marshal(venue)
+
+	req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewReader(venueJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	created := venue
+	created.ID = 1
+
+	mockVenuesService := &mocks.VenuesService{}
+	mockVenuesService.On("CreateVenue", mock.Anything, venue).Return(created, nil)
+
+	api := NewAPI(Services{Venues: mockVenuesService}, "", "")
+	if assert.NoError(t, api.createVenue(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "{\"id\":1,\"name\":\"Gelora Bung Karno\",\"city\":\"Jakarta\",\"country\":\"ID\",\"capacity\":77193,\"surface\":\"grass\",\"latitude\":-6.218,\"longitude\":106.802}\n", rec.Body.String())
+	}
+}
+
+func TestAPI_createVenueInvalidCoordinates(t *testing.T) {
+	latitude := 91.0
+	venue := models.Venue{Name: "Nowhere", Latitude: &latitude}
+	venueJSON, _ := json.Marshal(venue)
+
+	req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewReader(venueJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	mockVenuesService := &mocks.VenuesService{}
+	mockVenuesService.On("CreateVenue", mock.Anything, venue).
+		Return(models.Venue{}, services.NewValidationError("latitude must be between -90 and 90"))
+
+	api := NewAPI(Services{Venues: mockVenuesService}, "", "")
+	err := api.createVenue(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+func TestAPI_listVenueMatches(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/venues/4/matches", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/venues/:id/matches")
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+
+	venueID := int64(4)
+	filter := models.MatchFilter{VenueID: &venueID, Status: models.MatchScheduled}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("ListMatches", mock.Anything, filter).Return([]models.Match{}, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	if assert.NoError(t, api.listVenueMatches(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+
+	"soccer/pkg/live"
+)
+
+// WebSocket feed limits.
+const (
+	wsWriteWait      = 10 * time.Second
+	wsPongWait       = 60 * time.Second
+	wsPingPeriod     = wsPongWait * 9 / 10
+	wsMaxMessageSize = 4096
+)
+
+// Actions a WebSocket client can request.
+const (
+	wsSubscribe   = "subscribe"
+	wsUnsubscribe = "unsubscribe"
+)
+
+// Replies to WebSocket client requests, sent alongside the live messages.
+const (
+	wsSubscribed   = "subscribed"
+	wsUnsubscribed = "unsubscribed"
+	wsError        = "error"
+)
+
+// wsRequest is a message sent by a WebSocket client.
+type wsRequest struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics"`
+}
+
+var upgrader = websocket.Upgrader{
+	// The feed is public and read-only, so dashboards may be served from
+	// any origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// Follow live changes over WebSocket
+// @Summary Follow live changes over WebSocket
+// @Description Upgrade to a WebSocket receiving the changes to the followed topics as JSON messages {"topic", "type", "data"}. Send {"action": "subscribe" or "unsubscribe", "topics": ["match:1", "team:2", "competition:3"]} to choose the topics; each request is answered with a subscribed, unsubscribed or error message. Besides the message types of the match stream, teams publish team, team_deleted, player and player_deleted messages, and competitions competition and competition_deleted ones.
+// @Tags live
+// @ID serve-websocket
+// @Success 101 {string} string ""
+// @Router /ws [get]
+func (api *API) serveWebSocket(c echo.Context) error {
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// The upgrader has already replied with an HTTP error.
+		return nil
+	}
+	defer conn.Close()
+
+	sub := api.hub.Subscribe()
+	defer sub.Close()
+
+	replies := make(chan live.Message)
+	done := make(chan struct{})
+	defer close(done)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		readWebSocket(conn, sub, replies, done)
+	}()
+
+	ping := time.NewTicker(wsPingPeriod)
+	defer ping.Stop()
+
+	for {
+		var msg live.Message
+		select {
+		case <-closed:
+			return nil
+		case msg = <-replies:
+		case m, ok := <-sub.Messages():
+			if !ok {
+				return nil
+			}
+			msg = m
+		case <-ping.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return nil
+			}
+			continue
+		}
+
+		conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+		if err := conn.WriteJSON(msg); err != nil {
+			return nil
+		}
+	}
+}
+
+// readWebSocket applies the requests of a WebSocket client to its
+// subscription until the connection fails, handing the replies over to
+// the writer until it is done.
+func readWebSocket(conn *websocket.Conn, sub *live.Subscription, replies chan<- live.Message, done <-chan struct{}) {
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		reply := applyWebSocketRequest(sub, data)
+		select {
+		case replies <- reply:
+		case <-done:
+			return
+		}
+	}
+}
+
+// applyWebSocketRequest subscribes to or unsubscribes from the topics of a
+// request, rejecting it as a whole when it is invalid.
+func applyWebSocketRequest(sub *live.Subscription, data []byte) live.Message {
+	var req wsRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return live.Message{Type: wsError, Data: fmt.Sprintf("invalid request: %s", err)}
+	}
+	for _, topic := range req.Topics {
+		if !live.ValidTopic(topic) {
+			return live.Message{Type: wsError, Data: fmt.Sprintf("invalid topic: %s", topic)}
+		}
+	}
+
+	switch req.Action {
+	case wsSubscribe:
+		sub.Add(req.Topics...)
+		return live.Message{Type: wsSubscribed, Data: req.Topics}
+	case wsUnsubscribe:
+		sub.Remove(req.Topics...)
+		return live.Message{Type: wsUnsubscribed, Data: req.Topics}
+	default:
+		return live.Message{Type: wsError, Data: fmt.Sprintf("invalid action: %s", req.Action)}
+	}
+}
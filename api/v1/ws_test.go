@@ -0,0 +1,184 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"soccer/pkg/live"
+	"soccer/pkg/models"
+	"soccer/pkg/services/mocks"
+)
+
+func TestAPI_serveWebSocket(t *testing.T) {
+	api := NewAPI(Services{}, "", "")
+
+	e := echo.New()
+	e.GET("/ws", api.serveWebSocket)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	request := func(req string) string {
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		_, reply, err := conn.ReadMessage()
+		assert.NoError(t, err)
+		return string(reply)
+	}
+
+	assert.Equal(t, `{"topic":"","type":"error","data":"invalid topic: venue:1"}`+"\n",
+		request(`{"action":"subscribe","topics":["team:1","venue:1"]}`))
+	assert.Equal(t, `{"topic":"","type":"subscribed","data":["team:1","match:2"]}`+"\n",
+		request(`{"action":"subscribe","topics":["team:1","match:2"]}`))
+	assert.Equal(t, `{"topic":"","type":"unsubscribed","data":["match:2"]}`+"\n",
+		request(`{"action":"unsubscribe","topics":["match:2"]}`))
+
+	api.hub.Publish([]string{live.MatchTopic(2)}, live.TypeMatch, "unsubscribed")
+	api.hub.Publish([]string{live.TeamTopic(1)}, live.TypeTeam, map[string]int{"id": 1})
+
+	_, msg, err := conn.ReadMessage()
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"topic":"team:1","type":"team","data":{"id":1}}`+"\n", string(msg))
+	}
+}
+
+func TestAPI_createTeamPublishes(t *testing.T) {
+	team := models.Team{ID: 1, Name: "team-1"}
+	teamJSON, _ := json.Marshal(team)
+
+	req := httptest.NewRequest(http.MethodPost, "/teams", bytes.NewReader(teamJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+
+	mockTeamsService := &mocks.TeamsService{}
+	mockTeamsService.On("CreateTeam", mock.Anything, team).Return(team, nil)
+
+	api := NewAPI(Services{Teams: mockTeamsService}, "", "")
+	sub := api.hub.Subscribe(live.TeamTopic(1))
+	defer sub.Close()
+
+	if assert.NoError(t, api.createTeam(c)) {
+		assert.Equal(t, live.Message{Topic: "team:1", Type: live.TypeTeam, Data: team}, <-sub.Messages())
+	}
+}
+
+func TestAPI_updatePlayerPublishes(t *testing.T) {
+	player := models.Player{TeamID: 2, Name: "player-1", JerseyNumber: "11"}
+	playerJSON, _ := json.Marshal(player)
+
+	req := httptest.NewRequest(http.MethodPut, "/players/1", bytes.NewReader(playerJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	player.ID = 1
+	mockPlayersService := &mocks.PlayersService{}
+	mockPlayersService.On("GetPlayer", mock.Anything, int64(1)).Return(models.Player{ID: 1, TeamID: 1}, nil)
+	mockPlayersService.On("UpdatePlayer", mock.Anything, player).Return(player, nil)
+
+	api := NewAPI(Services{Players: mockPlayersService}, "", "")
+	sub := api.hub.Subscribe(live.TeamTopic(2))
+	defer sub.Close()
+	// The player moved from team 1, which hears of it too.
+	previous := api.hub.Subscribe(live.TeamTopic(1))
+	defer previous.Close()
+
+	if assert.NoError(t, api.updatePlayer(c)) {
+		assert.Equal(t, live.Message{Topic: "team:2", Type: live.TypePlayer, Data: player}, <-sub.Messages())
+		assert.Equal(t, live.Message{Topic: "team:1", Type: live.TypePlayer, Data: player}, <-previous.Messages())
+	}
+}
+
+func TestAPI_deleteMatchPublishes(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/matches/1", nil)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	competitionID := int64(3)
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("GetMatch", mock.Anything, int64(1)).
+		Return(models.Match{ID: 1, CompetitionID: &competitionID, HomeTeamID: 1, AwayTeamID: 2}, nil)
+	mockMatchesService.On("DeleteMatch", mock.Anything, int64(1)).Return(nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	team := api.hub.Subscribe(live.TeamTopic(2))
+	defer team.Close()
+	competition := api.hub.Subscribe(live.CompetitionTopic(3))
+	defer competition.Close()
+
+	if assert.NoError(t, api.deleteMatch(c)) {
+		deleted := map[string]int64{"id": 1}
+		assert.Equal(t, live.Message{Topic: "team:2", Type: live.TypeMatchDeleted, Data: deleted}, <-team.Messages())
+		assert.Equal(t, live.Message{Topic: "competition:3", Type: live.TypeMatchDeleted, Data: deleted}, <-competition.Messages())
+	}
+}
+
+func TestAPI_updateMatchPublishesNextMatch(t *testing.T) {
+	match := models.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Kickoff:    time.Date(2020, 9, 1, 19, 0, 0, 0, time.UTC),
+		Status:     models.MatchFinished,
+	}
+	matchJSON, _ := json.Marshal(match)
+
+	req := httptest.NewRequest(http.MethodPut, "/matches/1", bytes.NewReader(matchJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+	e.Validator = &mockRequestValidator{}
+	c := e.NewContext(req, rec)
+	c.SetPath("/matches/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	match.ID = 1
+	nextMatchID := int64(7)
+	updated := match
+	updated.HomeScore = 1
+	updated.NextMatchID = &nextMatchID
+	next := models.Match{ID: 7, HomeTeamID: 1, AwayTeamID: 4, Status: models.MatchScheduled}
+
+	mockMatchesService := &mocks.MatchesService{}
+	mockMatchesService.On("UpdateMatch", mock.Anything, match, false).Return(updated, nil)
+	mockMatchesService.On("GetMatch", mock.Anything, int64(7)).Return(next, nil)
+
+	api := NewAPI(Services{Matches: mockMatchesService}, "", "")
+	// The winner goes through to the next round, against team 4.
+	sub := api.hub.Subscribe(live.TeamTopic(4))
+	defer sub.Close()
+
+	if assert.NoError(t, api.updateMatch(c)) {
+		assert.Equal(t, live.Message{Topic: "team:4", Type: live.TypeMatch, Data: next}, <-sub.Messages())
+	}
+}
@@ -10,7 +10,9 @@ type Config struct {
 	AdminUsername string `envconfig:"ADMIN_USERNAME" default:"admin"`
 	AdminPassword string `envconfig:"ADMIN_PASSWORD" default:"admin"`
 
-	Database DatabaseConfig
+	Database   DatabaseConfig
+	Standings  StandingsConfig
+	Scheduling SchedulingConfig
 }
 
 // DatabaseConfig stores database configurations.
@@ -20,6 +22,18 @@ type DatabaseConfig struct {
 	MigrationsPath string `envconfig:"DATABASE_MIGRATIONS_PATH" required:"true" default:"file://migrations/postgresql"`
 }
 
+// StandingsConfig stores the points awarded in league tables.
+type StandingsConfig struct {
+	PointsForWin  int `envconfig:"POINTS_FOR_WIN" default:"3"`
+	PointsForDraw int `envconfig:"POINTS_FOR_DRAW" default:"1"`
+	PointsForLoss int `envconfig:"POINTS_FOR_LOSS" default:"0"`
+}
+
+// SchedulingConfig stores the constraints matches are scheduled under.
+type SchedulingConfig struct {
+	MinRestHours int `envconfig:"MIN_REST_HOURS" default:"48"`
+}
+
 // ReadConfig populates configurations from environment variables.
 func ReadConfig() (Config, error) {
 	var cfg Config
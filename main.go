@@ -58,7 +58,29 @@ func main() {
 
 	log.Println("Initializing services ...")
 	teamsService := services.NewTeamsService(db)
-	playersService := services.NewPlayersService(db)
+	schedulingRules := services.SchedulingRules{
+		MinRest: time.Duration(cfg.Scheduling.MinRestHours) * time.Hour,
+	}
+	matchesService := services.NewMatchesService(db, schedulingRules)
+	competitionsService := services.NewCompetitionsService(db)
+	seasonsService := services.NewSeasonsService(db)
+	matchEventsService := services.NewMatchEventsService(db)
+	statsService := services.NewStatsService(db)
+	venuesService := services.NewVenuesService(db)
+	refereesService := services.NewRefereesService(db)
+	staffService := services.NewStaffService(db)
+	suspensionsService := services.NewSuspensionsService(db)
+	playersService := services.NewPlayersService(db, suspensionsService)
+	lineupsService := services.NewLineupsService(db, playersService, suspensionsService)
+	injuriesService := services.NewInjuriesService(db, playersService, suspensionsService)
+	fixturesService := services.NewFixturesService(db, schedulingRules)
+	bracketsService := services.NewBracketsService(db, teamsService, schedulingRules)
+	squadsService := services.NewSquadsService(db)
+	standingsService := services.NewStandingsService(db, services.PointsSystem{
+		Win:  cfg.Standings.PointsForWin,
+		Draw: cfg.Standings.PointsForDraw,
+		Loss: cfg.Standings.PointsForLoss,
+	})
 
 	log.Println("Initializing the web server ...")
 	e := echo.New()
@@ -74,17 +96,51 @@ func main() {
 	e.GET("/ping", ping)
 
 	// Serve API
-	api := api.NewAPI(teamsService, playersService, cfg.AdminUsername, cfg.AdminPassword)
+	api := api.NewAPI(api.Services{
+		Teams:        teamsService,
+		Players:      playersService,
+		Matches:      matchesService,
+		Standings:    standingsService,
+		Competitions: competitionsService,
+		Seasons:      seasonsService,
+		MatchEvents:  matchEventsService,
+		Stats:        statsService,
+		Venues:       venuesService,
+		Referees:     refereesService,
+		Staff:        staffService,
+		Lineups:      lineupsService,
+		Fixtures:     fixturesService,
+		Brackets:     bracketsService,
+		Squads:       squadsService,
+		Suspensions:  suspensionsService,
+		Injuries:     injuriesService,
+	}, cfg.AdminUsername, cfg.AdminPassword)
 	api.Register(e.Group("/api/v1", middleware.Logger()))
 
 	// Start server
 	s := &http.Server{
-		Addr:         "0.0.0.0:" + cfg.Port,
-		WriteTimeout: 15 * time.Second,
-		ReadTimeout:  15 * time.Second,
+		Addr:        "0.0.0.0:" + cfg.Port,
+		Handler:     writeTimeout(e, 15*time.Second),
+		ReadTimeout: 15 * time.Second,
+		ErrorLog:    e.StdLogger,
 	}
 
-	e.Logger.Fatal(e.StartServer(s))
+	log.Printf("Listening on %s ...", s.Addr)
+	e.Logger.Fatal(s.ListenAndServe())
+}
+
+// writeTimeout bounds the time taken to serve each request, except for the
+// live streams which stay open as long as their clients listen. It stands
+// in for the server write timeout, which would cut the streams.
+func writeTimeout(h http.Handler, timeout time.Duration) http.Handler {
+	bounded := http.TimeoutHandler(h, timeout, "")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if api.IsStream(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		bounded.ServeHTTP(w, r)
+	})
 }
 
 type requestValidator struct{}
@@ -0,0 +1,8 @@
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS position TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS date_of_birth DATE,
+    ADD COLUMN IF NOT EXISTS nationality TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS height_cm INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS weight_kg INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS preferred_foot TEXT NOT NULL DEFAULT '';
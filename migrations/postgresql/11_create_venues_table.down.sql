@@ -0,0 +1,7 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue TEXT NOT NULL DEFAULT '';
+UPDATE matches m SET venue = v.name FROM venues v WHERE v.id = m.venue_id;
+
+DROP INDEX IF EXISTS matches_venue_id_idx;
+ALTER TABLE matches DROP COLUMN IF EXISTS venue_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS venue_id;
+DROP TABLE IF EXISTS venues;
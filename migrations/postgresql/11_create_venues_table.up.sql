@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS venues (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    city TEXT NOT NULL DEFAULT '',
+    country TEXT NOT NULL DEFAULT '',
+    capacity INT NOT NULL DEFAULT 0,
+    surface TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS venue_id INT REFERENCES venues (id) ON DELETE SET NULL;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id INT REFERENCES venues (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS matches_venue_id_idx ON matches (venue_id);
+
+-- Matches used to store the venue name; turn every name into a venue.
+INSERT INTO venues (name) SELECT DISTINCT venue FROM matches WHERE venue <> '';
+UPDATE matches m SET venue_id = v.id FROM venues v WHERE v.name = m.venue;
+ALTER TABLE matches DROP COLUMN IF EXISTS venue;
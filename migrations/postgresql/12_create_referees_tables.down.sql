@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS match_officials;
+DROP TABLE IF EXISTS referees;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS referees (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    nationality TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS match_officials (
+    match_id INT NOT NULL REFERENCES matches (id) ON DELETE CASCADE,
+    referee_id INT NOT NULL REFERENCES referees (id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (match_id, role),
+    CONSTRAINT match_officials_match_id_referee_id_key UNIQUE (match_id, referee_id)
+);
+CREATE INDEX IF NOT EXISTS match_officials_referee_id_idx ON match_officials (referee_id);
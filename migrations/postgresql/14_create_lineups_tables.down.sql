@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lineup_players;
+DROP TABLE IF EXISTS lineups;
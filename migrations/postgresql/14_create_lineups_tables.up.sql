@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS lineups (
+    match_id INT NOT NULL REFERENCES matches (id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    formation TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (match_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS lineup_players (
+    match_id INT NOT NULL,
+    team_id INT NOT NULL,
+    player_id INT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    starter BOOLEAN NOT NULL,
+    sort_order INT NOT NULL,
+    PRIMARY KEY (match_id, player_id),
+    FOREIGN KEY (match_id, team_id) REFERENCES lineups (match_id, team_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS lineup_players_player_id_idx ON lineup_players (player_id);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS bracket_nodes (
+    id SERIAL PRIMARY KEY,
+    competition_id INT NOT NULL REFERENCES competitions (id) ON DELETE CASCADE,
+    round INT NOT NULL,
+    position INT NOT NULL,
+    home_team_id INT REFERENCES teams (id) ON DELETE SET NULL,
+    away_team_id INT REFERENCES teams (id) ON DELETE SET NULL,
+    winner_team_id INT REFERENCES teams (id) ON DELETE SET NULL,
+    match_id INT UNIQUE REFERENCES matches (id) ON DELETE SET NULL,
+    kickoff TIMESTAMP NOT NULL,
+    UNIQUE (competition_id, round, position)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS squad_registrations;
+DROP TABLE IF EXISTS squad_rules;
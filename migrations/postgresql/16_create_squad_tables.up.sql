@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS squad_rules (
+    competition_id INT PRIMARY KEY REFERENCES competitions (id) ON DELETE CASCADE,
+    max_squad_size INT CHECK (max_squad_size >= 0),
+    min_homegrown INT CHECK (min_homegrown >= 0),
+    max_non_nationals INT CHECK (max_non_nationals >= 0),
+    nationality TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS squad_registrations (
+    season_id INT NOT NULL,
+    team_id INT NOT NULL,
+    player_id INT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    homegrown BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season_id, player_id),
+    FOREIGN KEY (season_id, team_id) REFERENCES season_teams (season_id, team_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS squad_registrations_team_id_idx ON squad_registrations (team_id, season_id);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS suspension_rules (
+    competition_id INT PRIMARY KEY REFERENCES competitions (id) ON DELETE CASCADE,
+    red_card_ban INT NOT NULL CHECK (red_card_ban >= 0),
+    yellow_card_limit INT NOT NULL CHECK (yellow_card_limit >= 0),
+    yellow_card_ban INT NOT NULL CHECK (yellow_card_ban >= 0),
+    updated_at TIMESTAMP
+);
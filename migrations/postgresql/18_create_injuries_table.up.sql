@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS injuries (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    expected_return DATE,
+    actual_return DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (expected_return IS NULL OR expected_return >= start_date),
+    CHECK (actual_return IS NULL OR actual_return >= start_date)
+);
+CREATE INDEX IF NOT EXISTS injuries_player_id_idx ON injuries (player_id);
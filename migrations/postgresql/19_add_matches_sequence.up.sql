@@ -0,0 +1 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS sequence INT NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS standings_tiebreakers (
+    competition_id INT NOT NULL REFERENCES competitions (id) ON DELETE CASCADE,
+    priority INT NOT NULL CHECK (priority >= 0),
+    tiebreaker TEXT NOT NULL,
+    PRIMARY KEY (competition_id, priority),
+    UNIQUE (competition_id, tiebreaker)
+);
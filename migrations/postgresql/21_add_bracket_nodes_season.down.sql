@@ -0,0 +1,16 @@
+-- The competition-wide key holds a single bracket per competition, so
+-- rolling back is lossy: each competition keeps the bracket drawn first
+-- and the nodes of the others are dropped, their matches staying on as
+-- ordinary matches.
+DELETE FROM bracket_nodes b
+WHERE b.season_id IS DISTINCT FROM (
+    SELECT f.season_id
+    FROM bracket_nodes f
+    WHERE f.competition_id = b.competition_id
+    ORDER BY f.id
+    LIMIT 1
+);
+
+ALTER TABLE bracket_nodes DROP CONSTRAINT IF EXISTS bracket_nodes_season_id_round_position_key;
+ALTER TABLE bracket_nodes DROP COLUMN IF EXISTS season_id;
+ALTER TABLE bracket_nodes ADD CONSTRAINT bracket_nodes_competition_id_round_position_key UNIQUE (competition_id, round, position);
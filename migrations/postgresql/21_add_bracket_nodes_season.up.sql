@@ -0,0 +1,21 @@
+ALTER TABLE bracket_nodes ADD COLUMN IF NOT EXISTS season_id INT REFERENCES seasons (id) ON DELETE CASCADE;
+
+-- Brackets drawn before they were scoped to a season belong to the season
+-- of their competition in which their first round was played.
+UPDATE bracket_nodes b SET season_id = (
+    SELECT s.id
+    FROM seasons s
+    WHERE s.competition_id = b.competition_id
+        AND (SELECT MIN(f.kickoff) FROM bracket_nodes f WHERE f.competition_id = b.competition_id)::DATE
+            BETWEEN s.start_date AND s.end_date
+    ORDER BY s.start_date
+    LIMIT 1
+)
+WHERE b.season_id IS NULL;
+
+UPDATE matches m SET season_id = b.season_id
+FROM bracket_nodes b
+WHERE b.match_id = m.id AND m.season_id IS NULL;
+
+ALTER TABLE bracket_nodes DROP CONSTRAINT IF EXISTS bracket_nodes_competition_id_round_position_key;
+ALTER TABLE bracket_nodes ADD CONSTRAINT bracket_nodes_season_id_round_position_key UNIQUE (season_id, round, position);
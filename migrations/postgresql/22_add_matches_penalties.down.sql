@@ -0,0 +1,3 @@
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_penalties_check;
+ALTER TABLE matches DROP COLUMN IF EXISTS away_penalties;
+ALTER TABLE matches DROP COLUMN IF EXISTS home_penalties;
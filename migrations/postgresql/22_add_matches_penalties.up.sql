@@ -0,0 +1,3 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS home_penalties INT CHECK (home_penalties >= 0);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS away_penalties INT CHECK (away_penalties >= 0);
+ALTER TABLE matches ADD CONSTRAINT matches_penalties_check CHECK ((home_penalties IS NULL) = (away_penalties IS NULL));
@@ -0,0 +1,2 @@
+-- The numbers rewritten or handed out cannot be told apart from the ones
+-- entered, so there is nothing to roll back.
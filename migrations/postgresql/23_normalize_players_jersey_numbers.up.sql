@@ -0,0 +1,42 @@
+-- Jersey numbers were free text when the unique key was added, so "07" and
+-- "7" could both be worn in a team, along with numbers out of 1 to 99.
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_team_id_jersey_number_key;
+
+-- Write the valid numbers without padding or leading zeros so that "07"
+-- and "7" collide.
+UPDATE players
+SET jersey_number = LTRIM(TRIM(jersey_number), '0')
+WHERE jersey_number ~ '^\s*0*[1-9][0-9]?\s*$';
+
+-- Team mates sharing a number keep it for the earliest registered player;
+-- the others, along with invalid numbers, get the lowest free number of
+-- their team.
+DO $$
+DECLARE
+    player RECORD;
+BEGIN
+    FOR player IN
+        SELECT p.id, p.team_id
+        FROM players p
+        WHERE p.jersey_number IS NOT NULL AND (
+            p.jersey_number !~ '^[1-9][0-9]?$'
+            OR EXISTS (
+                SELECT 1 FROM players q
+                WHERE q.team_id = p.team_id AND q.jersey_number = p.jersey_number AND q.id < p.id
+            )
+        )
+        ORDER BY p.id
+    LOOP
+        UPDATE players SET jersey_number = (
+            SELECT MIN(n)::TEXT
+            FROM generate_series(1, 99) n
+            WHERE NOT EXISTS (
+                SELECT 1 FROM players q
+                WHERE q.team_id = player.team_id AND q.jersey_number = n::TEXT
+            )
+        )
+        WHERE id = player.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE players ADD CONSTRAINT players_team_id_jersey_number_key UNIQUE (team_id, jersey_number);
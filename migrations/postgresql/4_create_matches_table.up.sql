@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS matches (
+    id SERIAL PRIMARY KEY,
+    home_team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    away_team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    kickoff TIMESTAMP NOT NULL,
+    venue TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'scheduled',
+    home_score INT NOT NULL DEFAULT 0,
+    away_score INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (home_team_id <> away_team_id)
+);
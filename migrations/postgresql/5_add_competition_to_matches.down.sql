@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS matches_competition_id_idx;
+ALTER TABLE matches DROP COLUMN IF EXISTS competition_id;
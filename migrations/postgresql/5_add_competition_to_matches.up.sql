@@ -0,0 +1,2 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS competition_id INT;
+CREATE INDEX IF NOT EXISTS matches_competition_id_idx ON matches (competition_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS matches_season_id_idx;
+ALTER TABLE matches DROP COLUMN IF EXISTS season_id;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_competition_id_fkey;
+DROP TABLE IF EXISTS season_teams;
+DROP TABLE IF EXISTS seasons;
+DROP TABLE IF EXISTS competitions;
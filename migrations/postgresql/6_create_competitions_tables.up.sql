@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS competitions (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    country TEXT NOT NULL DEFAULT '',
+    type TEXT NOT NULL DEFAULT 'league',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id SERIAL PRIMARY KEY,
+    competition_id INT NOT NULL REFERENCES competitions (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (start_date <= end_date)
+);
+
+CREATE TABLE IF NOT EXISTS season_teams (
+    season_id INT NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season_id, team_id)
+);
+
+ALTER TABLE matches
+    ADD CONSTRAINT matches_competition_id_fkey FOREIGN KEY (competition_id)
+    REFERENCES competitions (id) ON DELETE SET NULL NOT VALID;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS season_id INT REFERENCES seasons (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS matches_season_id_idx ON matches (season_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS match_events (
+    id SERIAL PRIMARY KEY,
+    match_id INT NOT NULL REFERENCES matches (id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    related_player_id INT REFERENCES players (id) ON DELETE SET NULL,
+    type TEXT NOT NULL,
+    minute INT NOT NULL DEFAULT 0,
+    added_time INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (player_id <> related_player_id)
+);
+CREATE INDEX IF NOT EXISTS match_events_match_id_idx ON match_events (match_id);
+CREATE INDEX IF NOT EXISTS match_events_player_id_idx ON match_events (player_id);
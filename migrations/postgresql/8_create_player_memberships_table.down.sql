@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS player_memberships;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'players' AND column_name = 'team_id'
+    ) THEN
+        ALTER TABLE players RENAME COLUMN team_id TO teams_id;
+    END IF;
+END $$;
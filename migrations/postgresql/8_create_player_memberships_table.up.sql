@@ -0,0 +1,25 @@
+-- The players table was created with a teams_id column while the code has
+-- always used team_id.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'players' AND column_name = 'teams_id'
+    ) THEN
+        ALTER TABLE players RENAME COLUMN teams_id TO team_id;
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS player_memberships (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    from_date DATE NOT NULL,
+    to_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (to_date IS NULL OR to_date >= from_date)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS player_memberships_open_idx ON player_memberships (player_id) WHERE to_date IS NULL;
+
+INSERT INTO player_memberships (player_id, team_id, from_date)
+SELECT id, team_id, created_at::DATE FROM players WHERE team_id IS NOT NULL;
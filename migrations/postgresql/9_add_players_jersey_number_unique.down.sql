@@ -0,0 +1 @@
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_team_id_jersey_number_key;
@@ -0,0 +1 @@
+ALTER TABLE players ADD CONSTRAINT players_team_id_jersey_number_key UNIQUE (team_id, jersey_number);
@@ -0,0 +1,211 @@
+// Package live fans out changes made through the API to the clients
+// following them, without going back to the database.
+package live
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// subscriptionBuffer is the number of messages a subscription holds before
+// the messages published to it are dropped.
+const subscriptionBuffer = 64
+
+// Message types.
+const (
+	TypeMatch              = "match"
+	TypeMatchDeleted       = "match_deleted"
+	TypeEventCreated       = "event_created"
+	TypeEventDeleted       = "event_deleted"
+	TypeLineup             = "lineup"
+	TypeOfficial           = "official"
+	TypeOfficialDeleted    = "official_deleted"
+	TypeTeam               = "team"
+	TypeTeamDeleted        = "team_deleted"
+	TypePlayer             = "player"
+	TypePlayerDeleted      = "player_deleted"
+	TypeCompetition        = "competition"
+	TypeCompetitionDeleted = "competition_deleted"
+)
+
+// Message is a change published on a topic.
+type Message struct {
+	Topic string      `json:"topic"`
+	Type  string      `json:"type"`
+	Data  interface{} `json:"data"`
+}
+
+// MatchTopic returns the topic of the changes to a match.
+func MatchTopic(id int64) string {
+	return fmt.Sprintf("match:%d", id)
+}
+
+// TeamTopic returns the topic of the changes to a team.
+func TeamTopic(id int64) string {
+	return fmt.Sprintf("team:%d", id)
+}
+
+// CompetitionTopic returns the topic of the changes to a competition.
+func CompetitionTopic(id int64) string {
+	return fmt.Sprintf("competition:%d", id)
+}
+
+var topicPattern = regexp.MustCompile(`^(match|team|competition):[1-9][0-9]*$`)
+
+// ValidTopic tells whether a topic is one of a match, a team or a
+// competition.
+func ValidTopic(topic string) bool {
+	return topicPattern.MatchString(topic)
+}
+
+// Hub delivers the messages published on a topic to every subscription
+// to it. It is safe for concurrent use.
+type Hub struct {
+	mu     sync.RWMutex
+	topics map[string]map[*Subscription]bool
+}
+
+// NewHub returns an initialized Hub.
+func NewHub() *Hub {
+	return &Hub{topics: make(map[string]map[*Subscription]bool)}
+}
+
+// Subscribe returns a subscription to the given topics. It must be closed
+// once the subscriber is done.
+func (h *Hub) Subscribe(topics ...string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &Subscription{
+		hub:      h,
+		messages: make(chan Message, subscriptionBuffer),
+		topics:   make(map[string]bool),
+	}
+	for _, topic := range topics {
+		h.add(sub, topic)
+	}
+
+	return sub
+}
+
+// Subscribed tells whether any subscription follows one of the topics.
+func (h *Hub) Subscribed(topics ...string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, topic := range topics {
+		if len(h.topics[topic]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Idle tells whether there is no subscription at all, in which case
+// publishers can skip gathering the data of their messages.
+func (h *Hub) Idle() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.topics) == 0
+}
+
+// Publish sends a message to the subscriptions of the given topics. A
+// subscription following several of them receives it once, on the first
+// topic it follows. Messages to a subscription whose buffer is full are
+// dropped rather than holding up the publisher.
+func (h *Hub) Publish(topics []string, typ string, data interface{}) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := make(map[*Subscription]bool)
+	for _, topic := range topics {
+		for sub := range h.topics[topic] {
+			if delivered[sub] {
+				continue
+			}
+			delivered[sub] = true
+
+			select {
+			case sub.messages <- Message{Topic: topic, Type: typ, Data: data}:
+			default:
+			}
+		}
+	}
+}
+
+// add registers the subscription to a topic. The caller must hold the
+// write lock.
+func (h *Hub) add(sub *Subscription, topic string) {
+	if h.topics[topic] == nil {
+		h.topics[topic] = make(map[*Subscription]bool)
+	}
+	h.topics[topic][sub] = true
+	sub.topics[topic] = true
+}
+
+// remove unregisters the subscription from a topic. The caller must hold
+// the write lock.
+func (h *Hub) remove(sub *Subscription, topic string) {
+	delete(h.topics[topic], sub)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
+	}
+	delete(sub.topics, topic)
+}
+
+// Subscription receives the messages published on the topics it follows.
+type Subscription struct {
+	hub      *Hub
+	messages chan Message
+	topics   map[string]bool
+	closed   bool
+}
+
+// Messages returns the channel the messages are delivered on. It is closed
+// when the subscription is.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Add subscribes to more topics.
+func (s *Subscription) Add(topics ...string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	for _, topic := range topics {
+		s.hub.add(s, topic)
+	}
+}
+
+// Remove unsubscribes from some of the topics.
+func (s *Subscription) Remove(topics ...string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	for _, topic := range topics {
+		if s.topics[topic] {
+			s.hub.remove(s, topic)
+		}
+	}
+}
+
+// Close unsubscribes from every topic and closes the messages channel.
+// Closing twice is a no-op.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	for topic := range s.topics {
+		s.hub.remove(s, topic)
+	}
+	s.closed = true
+	close(s.messages)
+}
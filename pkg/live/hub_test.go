@@ -0,0 +1,77 @@
+package live
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_Publish(t *testing.T) {
+	hub := NewHub()
+	match := hub.Subscribe(MatchTopic(1))
+	both := hub.Subscribe(MatchTopic(1), TeamTopic(2))
+	other := hub.Subscribe(MatchTopic(3))
+
+	hub.Publish([]string{MatchTopic(1), TeamTopic(2)}, TypeMatch, "data")
+
+	assert.Equal(t, Message{Topic: "match:1", Type: TypeMatch, Data: "data"}, <-match.Messages())
+	assert.Equal(t, Message{Topic: "match:1", Type: TypeMatch, Data: "data"}, <-both.Messages())
+	assert.Len(t, both.Messages(), 0)
+	assert.Len(t, other.Messages(), 0)
+}
+
+func TestHub_PublishFullBuffer(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe(MatchTopic(1))
+
+	for i := 0; i < subscriptionBuffer+1; i++ {
+		hub.Publish([]string{MatchTopic(1)}, TypeMatch, i)
+	}
+
+	assert.Len(t, sub.Messages(), subscriptionBuffer)
+	assert.Equal(t, 0, (<-sub.Messages()).Data)
+}
+
+func TestSubscription_Close(t *testing.T) {
+	hub := NewHub()
+	assert.True(t, hub.Idle())
+	sub := hub.Subscribe(MatchTopic(1))
+	assert.True(t, hub.Subscribed(MatchTopic(1)))
+	assert.False(t, hub.Idle())
+
+	sub.Close()
+	sub.Close()
+
+	assert.False(t, hub.Subscribed(MatchTopic(1)))
+	assert.True(t, hub.Idle())
+	_, ok := <-sub.Messages()
+	assert.False(t, ok)
+
+	hub.Publish([]string{MatchTopic(1)}, TypeMatch, nil)
+}
+
+func TestSubscription_AddRemove(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe()
+	defer sub.Close()
+
+	sub.Add(TeamTopic(1), CompetitionTopic(2))
+	sub.Remove(TeamTopic(1), MatchTopic(3))
+
+	hub.Publish([]string{TeamTopic(1)}, TypeTeam, 1)
+	hub.Publish([]string{CompetitionTopic(2)}, TypeMatch, 2)
+
+	assert.Equal(t, Message{Topic: "competition:2", Type: TypeMatch, Data: 2}, <-sub.Messages())
+	assert.Len(t, sub.Messages(), 0)
+	assert.False(t, hub.Subscribed(TeamTopic(1)))
+}
+
+func TestValidTopic(t *testing.T) {
+	assert.True(t, ValidTopic("match:1"))
+	assert.True(t, ValidTopic("team:20"))
+	assert.True(t, ValidTopic("competition:3"))
+	assert.False(t, ValidTopic("venue:1"))
+	assert.False(t, ValidTopic("match:0"))
+	assert.False(t, ValidTopic("match:"))
+	assert.False(t, ValidTopic("match:1 "))
+}
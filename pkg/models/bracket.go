@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// BracketNode is a tie of a knockout bracket drawn for a season of a cup
+// competition. Rounds are numbered from 1,
+// the final being the last one. The winner of the tie at a position moves
+// on to the tie at half that position in the next round, as the home side
+// when coming from an even position.
+type BracketNode struct {
+	ID            int64         `json:"id" db:"id"`
+	CompetitionID int64         `json:"competition_id" db:"competition_id"`
+	SeasonID      *int64        `json:"season_id" db:"season_id"`
+	Round         int           `json:"round" db:"round"`
+	Position      int           `json:"position" db:"position"`
+	HomeTeamID    *int64        `json:"home_team_id" db:"home_team_id"`
+	AwayTeamID    *int64        `json:"away_team_id" db:"away_team_id"`
+	WinnerTeamID  *int64        `json:"winner_team_id" db:"winner_team_id"`
+	MatchID       *int64        `json:"match_id" db:"match_id"`
+	Kickoff       time.Time     `json:"kickoff" db:"kickoff"`
+	Children      []BracketNode `json:"children,omitempty" db:"-"`
+}
+
+// Bracket is the knockout bracket of a season, as the tree of ties leading
+// to the final.
+type Bracket struct {
+	CompetitionID int64       `json:"competition_id"`
+	SeasonID      int64       `json:"season_id"`
+	Rounds        int         `json:"rounds"`
+	Final         BracketNode `json:"final"`
+
+	// Conflicts lists the scheduling conflicts of the first round ties
+	// overridden when the draw was forced through.
+	Conflicts []Conflict `json:"conflicts,omitempty" readonly:"true"`
+}
+
+// BracketDraw lists the teams entering the knockout bracket of a season,
+// best seed first, and when its rounds are played.
+type BracketDraw struct {
+	SeasonID          int64     `json:"-"`
+	TeamIDs           []int64   `json:"team_ids"`
+	FirstKickoff      time.Time `json:"first_kickoff" valid:"required" example:"2020-09-01T19:00:00Z"`
+	DaysBetweenRounds int       `json:"days_between_rounds" valid:"range(1|365)" example:"7"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// CalendarMatch is a match as listed in a team calendar. The sequence
+// counts the times the match was moved, so that calendar apps pick up the
+// latest schedule.
+type CalendarMatch struct {
+	ID              int64      `db:"id"`
+	CompetitionName *string    `db:"competition_name"`
+	HomeTeamName    string     `db:"home_team_name"`
+	AwayTeamName    string     `db:"away_team_name"`
+	Kickoff         time.Time  `db:"kickoff"`
+	VenueName       *string    `db:"venue_name"`
+	VenueCity       *string    `db:"venue_city"`
+	Status          string     `db:"status"`
+	HomeScore       int        `db:"home_score"`
+	AwayScore       int        `db:"away_score"`
+	Sequence        int        `db:"sequence"`
+	UpdatedAt       *time.Time `db:"updated_at"`
+}
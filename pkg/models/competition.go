@@ -0,0 +1,17 @@
+package models
+
+// Competition types.
+const (
+	CompetitionLeague = "league"
+	CompetitionCup    = "cup"
+)
+
+// Competition model.
+type Competition struct {
+	CreatedUpdated
+
+	ID      int64  `json:"id" db:"id"`
+	Name    string `json:"name" db:"name" valid:"required"`
+	Country string `json:"country" db:"country"`
+	Type    string `json:"type" db:"type" valid:"in(league|cup)"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// Scheduling conflict types.
+const (
+	ConflictTeamRest = "team_rest"
+	ConflictVenue    = "venue"
+	ConflictOfficial = "official"
+)
+
+// Conflict is a clash between the schedule of a match and another match:
+// a team without enough rest between the two, a venue hosting both on the
+// same day, or an official assigned to both.
+type Conflict struct {
+	Type    string `json:"type"`
+	MatchID int64  `json:"match_id"`
+	Message string `json:"message"`
+}
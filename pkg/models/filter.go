@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// MatchFilter narrows down the list of matches.
+type MatchFilter struct {
+	CompetitionID *int64
+	SeasonID      *int64
+	TeamID        *int64
+	VenueID       *int64
+	Status        string
+}
+
+// StatsFilter narrows down the matches statistics are aggregated from.
+type StatsFilter struct {
+	SeasonID *int64
+}
+
+// HeadToHeadFilter narrows down the meetings between two teams. The date
+// range includes both ends.
+type HeadToHeadFilter struct {
+	CompetitionID *int64
+	From          *time.Time
+	To            *time.Time
+}
+
+// FormFilter narrows down the results of a form guide. An empty side
+// includes both home and away matches.
+type FormFilter struct {
+	Last int
+	Side string
+}
+
+// Pagination selects a page of a list, the first page being 1.
+type Pagination struct {
+	Page    int
+	PerPage int
+}
+
+// PlayerFilter narrows down the list of players.
+type PlayerFilter struct {
+	Position      string
+	Role          string
+	Nationality   string
+	PreferredFoot string
+	MinAge        *int
+	MaxAge        *int
+	MinHeightCm   *int
+	MaxHeightCm   *int
+}
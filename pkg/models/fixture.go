@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// FixtureSchedule describes the round-robin fixtures to generate for the
+// teams registered in a season.
+type FixtureSchedule struct {
+	SeasonID          int64     `json:"-"`
+	Double            bool      `json:"double"`
+	FirstKickoff      time.Time `json:"first_kickoff" valid:"required" example:"2020-08-15T19:00:00Z"`
+	DaysBetweenRounds int       `json:"days_between_rounds" valid:"range(1|365)" example:"7"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Injury of a player. The player is out from the start date until the
+// actual return, the expected return being the medical staff's estimate.
+type Injury struct {
+	CreatedUpdated
+
+	ID             int64      `json:"id" db:"id"`
+	PlayerID       int64      `json:"player_id" db:"player_id"`
+	Type           string     `json:"type" db:"type" valid:"required" example:"hamstring strain"`
+	StartDate      time.Time  `json:"start_date" db:"start_date" valid:"required" example:"2020-10-03T00:00:00Z"`
+	ExpectedReturn *time.Time `json:"expected_return,omitempty" db:"expected_return" example:"2020-10-24T00:00:00Z"`
+	ActualReturn   *time.Time `json:"actual_return,omitempty" db:"actual_return" example:"2020-10-21T00:00:00Z"`
+}
+
+// TeamAvailability sorts the players of a team into those injured,
+// suspended for the team's next match, and fit on a given date. A player
+// both injured and suspended is listed as injured.
+type TeamAvailability struct {
+	TeamID      int64     `json:"team_id"`
+	Date        time.Time `json:"date"`
+	NextMatchID *int64    `json:"next_match_id"`
+	Injured     []Player  `json:"injured"`
+	Suspended   []Player  `json:"suspended"`
+	Fit         []Player  `json:"fit"`
+}
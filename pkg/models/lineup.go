@@ -0,0 +1,14 @@
+package models
+
+// StartersCount is the number of players in a starting lineup.
+const StartersCount = 11
+
+// Lineup is the team sheet of a team for a match: its formation, starting
+// eleven and substitutes, listed by player id.
+type Lineup struct {
+	MatchID   int64   `json:"match_id"`
+	TeamID    int64   `json:"team_id"`
+	Formation string  `json:"formation" valid:"required" example:"4-3-3"`
+	Starters  []int64 `json:"starters"`
+	Bench     []int64 `json:"bench"`
+}
@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// Match statuses.
+const (
+	MatchScheduled = "scheduled"
+	MatchLive      = "live"
+	MatchFinished  = "finished"
+	MatchPostponed = "postponed"
+	MatchCancelled = "cancelled"
+)
+
+// Match model. The score is derived from the match goal events and
+// cannot be set directly. The penalty shoot-out score decides a knockout
+// tie still level after extra time.
+type Match struct {
+	CreatedUpdated
+
+	ID            int64     `json:"id" db:"id"`
+	CompetitionID *int64    `json:"competition_id,omitempty" db:"competition_id"`
+	SeasonID      *int64    `json:"season_id,omitempty" db:"season_id"`
+	HomeTeamID    int64     `json:"home_team_id" db:"home_team_id" valid:"required"`
+	AwayTeamID    int64     `json:"away_team_id" db:"away_team_id" valid:"required"`
+	Kickoff       time.Time `json:"kickoff" db:"kickoff" valid:"required" example:"2020-04-21T15:00:00Z"`
+	VenueID       *int64    `json:"venue_id,omitempty" db:"venue_id"`
+	Status        string    `json:"status" db:"status" valid:"in(scheduled|live|finished|postponed|cancelled)"`
+	HomeScore     int       `json:"home_score" db:"home_score" readonly:"true"`
+	AwayScore     int       `json:"away_score" db:"away_score" readonly:"true"`
+	HomePenalties *int      `json:"home_penalties,omitempty" db:"home_penalties"`
+	AwayPenalties *int      `json:"away_penalties,omitempty" db:"away_penalties"`
+
+	// NextMatchID is the match of the next round of a bracket the winner of
+	// the match goes through to, once it is scheduled.
+	NextMatchID *int64 `json:"next_match_id,omitempty" db:"next_match_id" readonly:"true"`
+
+	// Conflicts lists the scheduling conflicts overridden when the match
+	// was forced through.
+	Conflicts []Conflict `json:"conflicts,omitempty" db:"-" readonly:"true"`
+}
+
+// Sides a team can play a match on.
+const (
+	SideHome = "home"
+	SideAway = "away"
+)
+
+// Match results from the point of view of one team.
+const (
+	ResultWin  = "W"
+	ResultDraw = "D"
+	ResultLoss = "L"
+)
+
+// ScoreFor returns the goals scored and conceded by the given team.
+func (m Match) ScoreFor(teamID int64) (scored, conceded int) {
+	if teamID == m.HomeTeamID {
+		return m.HomeScore, m.AwayScore
+	}
+	return m.AwayScore, m.HomeScore
+}
+
+// ResultFor returns the result of the match for the given team.
+func (m Match) ResultFor(teamID int64) string {
+	return Result(m.ScoreFor(teamID))
+}
+
+// Result returns the result of a team that scored and conceded the given
+// goals.
+func Result(scored, conceded int) string {
+	switch {
+	case scored > conceded:
+		return ResultWin
+	case scored < conceded:
+		return ResultLoss
+	default:
+		return ResultDraw
+	}
+}
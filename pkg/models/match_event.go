@@ -0,0 +1,28 @@
+package models
+
+// Match event types.
+const (
+	EventGoal         = "goal"
+	EventOwnGoal      = "own_goal"
+	EventYellowCard   = "yellow_card"
+	EventSecondYellow = "second_yellow"
+	EventRedCard      = "red_card"
+	EventSubstitution = "substitution"
+)
+
+// MatchEvent model. PlayerID is the scorer, the booked player or the
+// substitute coming on, and RelatedPlayerID the assisting player or the
+// player coming off. An own goal is recorded against the team of the
+// player who scored it.
+type MatchEvent struct {
+	CreatedUpdated
+
+	ID              int64  `json:"id" db:"id"`
+	MatchID         int64  `json:"match_id" db:"match_id"`
+	TeamID          int64  `json:"team_id" db:"team_id" valid:"required"`
+	PlayerID        int64  `json:"player_id" db:"player_id" valid:"required"`
+	RelatedPlayerID *int64 `json:"related_player_id,omitempty" db:"related_player_id"`
+	Type            string `json:"type" db:"type" valid:"required,in(goal|own_goal|yellow_card|second_yellow|red_card|substitution)"`
+	Minute          int    `json:"minute" db:"minute" valid:"range(0|130)"`
+	AddedTime       int    `json:"added_time" db:"added_time" valid:"range(0|30)"`
+}
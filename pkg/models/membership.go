@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// PlayerMembership records a spell of a player at a team. An open
+// membership, without an end date, is the player's current team.
+type PlayerMembership struct {
+	ID       int64      `json:"id" db:"id"`
+	PlayerID int64      `json:"player_id" db:"player_id"`
+	TeamID   int64      `json:"team_id" db:"team_id"`
+	TeamName string     `json:"team_name" db:"team_name"`
+	FromDate time.Time  `json:"from_date" db:"from_date" example:"2020-07-01T00:00:00Z"`
+	ToDate   *time.Time `json:"to_date,omitempty" db:"to_date" example:"2021-06-30T00:00:00Z"`
+}
+
+// Transfer moves a player to another team.
+type Transfer struct {
+	PlayerID     int64     `json:"-"`
+	TeamID       int64     `json:"team_id" valid:"required"`
+	Date         time.Time `json:"date" valid:"required" example:"2021-01-31T00:00:00Z"`
+	JerseyNumber string    `json:"jersey_number"`
+}
@@ -1,11 +1,58 @@
 package models
 
-// Player model.
+import "time"
+
+// Player positions.
+const (
+	PositionGoalkeeper = "GK"
+	PositionDefender   = "DF"
+	PositionMidfielder = "MF"
+	PositionForward    = "FW"
+)
+
+// RolePositions maps each detailed role to its position.
+var RolePositions = map[string]string{
+	"GK":  PositionGoalkeeper,
+	"CB":  PositionDefender,
+	"LB":  PositionDefender,
+	"RB":  PositionDefender,
+	"LWB": PositionDefender,
+	"RWB": PositionDefender,
+	"DM":  PositionMidfielder,
+	"CM":  PositionMidfielder,
+	"AM":  PositionMidfielder,
+	"LM":  PositionMidfielder,
+	"RM":  PositionMidfielder,
+	"LW":  PositionForward,
+	"RW":  PositionForward,
+	"SS":  PositionForward,
+	"CF":  PositionForward,
+	"ST":  PositionForward,
+}
+
+// Player availabilities.
+const (
+	AvailabilityFit       = "fit"
+	AvailabilityInjured   = "injured"
+	AvailabilitySuspended = "suspended"
+)
+
+// Player model. Availability tells whether the player is injured today or
+// suspended for the next scheduled match of the team, as team availability.
 type Player struct {
 	CreatedUpdated
 
-	ID           int64  `json:"id" db:"id"`
-	TeamID       int64  `json:"team_id" db:"team_id" valid:"required"`
-	Name         string `json:"name" db:"name" valid:"required"`
-	JerseyNumber string `json:"jersey_number" db:"jersey_number" valid:"required"`
+	ID            int64      `json:"id" db:"id"`
+	TeamID        int64      `json:"team_id" db:"team_id" valid:"required"`
+	Name          string     `json:"name" db:"name" valid:"required"`
+	JerseyNumber  string     `json:"jersey_number" db:"jersey_number" valid:"required"`
+	Position      string     `json:"position,omitempty" db:"position" valid:"in(GK|DF|MF|FW)"`
+	Role          string     `json:"role,omitempty" db:"role" valid:"in(GK|CB|LB|RB|LWB|RWB|DM|CM|AM|LM|RM|LW|RW|SS|CF|ST)"`
+	DateOfBirth   *time.Time `json:"date_of_birth,omitempty" db:"date_of_birth" example:"1995-06-24T00:00:00Z"`
+	Age           *int       `json:"age,omitempty" db:"age" readonly:"true"`
+	Nationality   string     `json:"nationality,omitempty" db:"nationality" example:"ID"`
+	HeightCm      int        `json:"height_cm,omitempty" db:"height_cm" valid:"range(100|250)"`
+	WeightKg      int        `json:"weight_kg,omitempty" db:"weight_kg" valid:"range(30|150)"`
+	PreferredFoot string     `json:"preferred_foot,omitempty" db:"preferred_foot" valid:"in(left|right|both)"`
+	Availability  string     `json:"availability,omitempty" db:"availability" readonly:"true"`
 }
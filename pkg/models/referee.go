@@ -0,0 +1,33 @@
+package models
+
+// Match official roles.
+const (
+	OfficialReferee        = "referee"
+	OfficialAssistant1     = "assistant_1"
+	OfficialAssistant2     = "assistant_2"
+	OfficialFourthOfficial = "fourth_official"
+)
+
+// Referee model.
+type Referee struct {
+	CreatedUpdated
+
+	ID          int64  `json:"id" db:"id"`
+	Name        string `json:"name" db:"name" valid:"required"`
+	Nationality string `json:"nationality" db:"nationality" valid:"ISO3166Alpha2" example:"ID"`
+}
+
+// MatchOfficial assigns a referee to a match in a given role.
+type MatchOfficial struct {
+	MatchID     int64  `json:"match_id" db:"match_id"`
+	RefereeID   int64  `json:"referee_id" db:"referee_id" valid:"required"`
+	RefereeName string `json:"referee_name,omitempty" db:"referee_name" readonly:"true"`
+	Role        string `json:"role" db:"role" valid:"required,in(referee|assistant_1|assistant_2|fourth_official)"`
+}
+
+// RefereeAssignment is a match officiated by a referee, and their role in it.
+type RefereeAssignment struct {
+	Match
+
+	Role string `json:"role" db:"role"`
+}
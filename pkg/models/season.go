@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Season model.
+type Season struct {
+	CreatedUpdated
+
+	ID            int64     `json:"id" db:"id"`
+	CompetitionID int64     `json:"competition_id" db:"competition_id" valid:"required"`
+	Name          string    `json:"name" db:"name" valid:"required" example:"2020/2021"`
+	StartDate     time.Time `json:"start_date" db:"start_date" valid:"required" example:"2020-08-01T00:00:00Z"`
+	EndDate       time.Time `json:"end_date" db:"end_date" valid:"required" example:"2021-05-31T00:00:00Z"`
+}
+
+// SeasonTeam registers a team in a season.
+type SeasonTeam struct {
+	SeasonID int64 `json:"season_id" db:"season_id"`
+	TeamID   int64 `json:"team_id" db:"team_id" valid:"required"`
+}
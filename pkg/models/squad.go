@@ -0,0 +1,35 @@
+package models
+
+// SquadRules are the rules the season squads of a competition must follow.
+// A rule without a value is not enforced. Players whose nationality differs
+// from the competition's nationality count as non-nationals.
+type SquadRules struct {
+	CompetitionID   int64  `json:"competition_id" db:"competition_id"`
+	MaxSquadSize    *int   `json:"max_squad_size" db:"max_squad_size" example:"25"`
+	MinHomegrown    *int   `json:"min_homegrown" db:"min_homegrown" example:"8"`
+	MaxNonNationals *int   `json:"max_non_nationals" db:"max_non_nationals" example:"5"`
+	Nationality     string `json:"nationality" db:"nationality" valid:"ISO3166Alpha2" example:"ID"`
+}
+
+// SquadRegistration registers a player in the squad of a team for a season.
+type SquadRegistration struct {
+	SeasonID    int64  `json:"season_id" db:"season_id" valid:"required"`
+	TeamID      int64  `json:"team_id" db:"team_id"`
+	PlayerID    int64  `json:"player_id" db:"player_id" valid:"required"`
+	Homegrown   bool   `json:"homegrown" db:"homegrown"`
+	PlayerName  string `json:"player_name,omitempty" db:"player_name" readonly:"true"`
+	Nationality string `json:"nationality,omitempty" db:"nationality" readonly:"true"`
+}
+
+// SquadCompliance reports how the season squad of a team measures up
+// against the rules of its competition.
+type SquadCompliance struct {
+	TeamID       int64      `json:"team_id"`
+	SeasonID     int64      `json:"season_id"`
+	Rules        SquadRules `json:"rules"`
+	SquadSize    int        `json:"squad_size"`
+	Homegrown    int        `json:"homegrown"`
+	NonNationals int        `json:"non_nationals"`
+	Compliant    bool       `json:"compliant"`
+	Violations   []string   `json:"violations"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Staff roles.
+const (
+	StaffHeadCoach        = "head_coach"
+	StaffAssistantCoach   = "assistant_coach"
+	StaffGoalkeepingCoach = "goalkeeping_coach"
+	StaffPhysio           = "physio"
+)
+
+// Staff is a member of a team's staff for the duration of a tenure. A
+// tenure without an end date is still running.
+type Staff struct {
+	CreatedUpdated
+
+	ID        int64      `json:"id" db:"id"`
+	TeamID    int64      `json:"team_id" db:"team_id"`
+	Name      string     `json:"name" db:"name" valid:"required"`
+	Role      string     `json:"role" db:"role" valid:"required,in(head_coach|assistant_coach|goalkeeping_coach|physio)"`
+	StartDate time.Time  `json:"start_date" db:"start_date" valid:"required" example:"2020-07-01T00:00:00Z"`
+	EndDate   *time.Time `json:"end_date,omitempty" db:"end_date" example:"2021-06-30T00:00:00Z"`
+}
+
+// CoachRecord is the record of a team's results during a head coach's
+// tenure.
+type CoachRecord struct {
+	StaffID      int64      `json:"staff_id"`
+	Name         string     `json:"name"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date,omitempty"`
+	Played       int        `json:"played"`
+	Won          int        `json:"won"`
+	Drawn        int        `json:"drawn"`
+	Lost         int        `json:"lost"`
+	GoalsFor     int        `json:"goals_for"`
+	GoalsAgainst int        `json:"goals_against"`
+}
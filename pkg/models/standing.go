@@ -0,0 +1,41 @@
+package models
+
+// Standings tiebreakers, applied in a competition's order to teams level
+// on points. The head-to-head ones only count the matches between the
+// teams still level, as a mini-league among them, re-applied to any
+// smaller group they leave level before the next tiebreakers.
+const (
+	TiebreakerGoalDifference           = "goal_difference"
+	TiebreakerGoalsScored              = "goals_scored"
+	TiebreakerHeadToHeadPoints         = "head_to_head_points"
+	TiebreakerHeadToHeadGoalDifference = "head_to_head_goal_difference"
+	TiebreakerHeadToHeadGoalsScored    = "head_to_head_goals_scored"
+	TiebreakerAwayGoals                = "away_goals"
+	TiebreakerFairPlay                 = "fair_play"
+	TiebreakerDrawingOfLots            = "drawing_of_lots"
+)
+
+// Standing is a team's row in a league table. The form holds the results
+// of the team's latest matches, most recent first.
+type Standing struct {
+	Position       int    `json:"position"`
+	TeamID         int64  `json:"team_id"`
+	TeamName       string `json:"team_name"`
+	Played         int    `json:"played"`
+	Won            int    `json:"won"`
+	Drawn          int    `json:"drawn"`
+	Lost           int    `json:"lost"`
+	GoalsFor       int    `json:"goals_for"`
+	GoalsAgainst   int    `json:"goals_against"`
+	GoalDifference int    `json:"goal_difference"`
+	Points         int    `json:"points"`
+	Form           string `json:"form"`
+}
+
+// StandingsRules configure how a competition orders teams level on points:
+// each tiebreaker in turn splits the teams still level after the previous
+// ones, and team name settles whatever is left.
+type StandingsRules struct {
+	CompetitionID int64    `json:"competition_id"`
+	Tiebreakers   []string `json:"tiebreakers" example:"goal_difference,goals_scored"`
+}
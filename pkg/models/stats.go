@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+// PlayerStats aggregates the match record of a player.
+type PlayerStats struct {
+	PlayerID    int64 `json:"player_id"`
+	Appearances int   `json:"appearances"`
+	Minutes     int   `json:"minutes"`
+	Goals       int   `json:"goals"`
+	Assists     int   `json:"assists"`
+	YellowCards int   `json:"yellow_cards"`
+	RedCards    int   `json:"red_cards"`
+}
+
+// TeamStats aggregates the match record of a team and its players.
+type TeamStats struct {
+	TeamID      int64         `json:"team_id"`
+	Matches     int           `json:"matches"`
+	Goals       int           `json:"goals"`
+	Assists     int           `json:"assists"`
+	YellowCards int           `json:"yellow_cards"`
+	RedCards    int           `json:"red_cards"`
+	Players     []PlayerStats `json:"players"`
+}
+
+// HeadToHead sums up the past meetings between a team and an opponent,
+// from the point of view of the former.
+type HeadToHead struct {
+	TeamID             int64   `json:"team_id"`
+	OpponentID         int64   `json:"opponent_id"`
+	Played             int     `json:"played"`
+	Won                int     `json:"won"`
+	Drawn              int     `json:"drawn"`
+	Lost               int     `json:"lost"`
+	GoalsFor           int     `json:"goals_for"`
+	GoalsAgainst       int     `json:"goals_against"`
+	BiggestWin         *Match  `json:"biggest_win"`
+	OpponentBiggestWin *Match  `json:"opponent_biggest_win"`
+	Matches            []Match `json:"matches"`
+}
+
+// FormResult is a finished match from the point of view of one team.
+type FormResult struct {
+	MatchID      int64     `json:"match_id" db:"match_id"`
+	Kickoff      time.Time `json:"kickoff" db:"kickoff"`
+	Side         string    `json:"side" db:"side"`
+	OpponentID   int64     `json:"opponent_id" db:"opponent_id"`
+	OpponentName string    `json:"opponent_name" db:"opponent_name"`
+	GoalsFor     int       `json:"goals_for" db:"goals_for"`
+	GoalsAgainst int       `json:"goals_against" db:"goals_against"`
+	Result       string    `json:"result"`
+}
+
+// Form lists the latest results of a team, most recent first. The form
+// string joins them in the same order, e.g. "WWDLW".
+type Form struct {
+	TeamID  int64        `json:"team_id"`
+	Form    string       `json:"form"`
+	Results []FormResult `json:"results"`
+}
+
+// Leaderboard categories.
+const (
+	LeaderboardGoals       = "goals"
+	LeaderboardAssists     = "assists"
+	LeaderboardCleanSheets = "clean_sheets"
+	LeaderboardCards       = "cards"
+)
+
+// LeaderboardEntry is a player's row in a leaderboard. Players level on
+// value and minutes share the same rank.
+type LeaderboardEntry struct {
+	Rank        int    `json:"rank"`
+	PlayerID    int64  `json:"player_id"`
+	PlayerName  string `json:"player_name"`
+	TeamID      int64  `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	Value       int    `json:"value"`
+	Appearances int    `json:"appearances"`
+	Minutes     int    `json:"minutes"`
+}
+
+// Leaderboard is a page of the players of a season ranked in a category.
+type Leaderboard struct {
+	SeasonID int64              `json:"season_id"`
+	Category string             `json:"category"`
+	Page     int                `json:"page"`
+	PerPage  int                `json:"per_page"`
+	Total    int                `json:"total"`
+	Entries  []LeaderboardEntry `json:"entries"`
+}
@@ -0,0 +1,34 @@
+package models
+
+// Suspension reasons.
+const (
+	SuspensionRedCard     = "red_card"
+	SuspensionYellowCards = "yellow_cards"
+)
+
+// SuspensionRules configure the bans of a competition: a player sent off is
+// banned for the next RedCardBan matches, and every YellowCardLimit yellow
+// cards ban them for the next YellowCardBan matches. A zero limit disables
+// yellow card accumulation. Cards and bans do not carry over between seasons.
+type SuspensionRules struct {
+	CompetitionID   int64 `json:"competition_id" db:"competition_id"`
+	RedCardBan      int   `json:"red_card_ban" db:"red_card_ban" valid:"range(0|20)" example:"1"`
+	YellowCardLimit int   `json:"yellow_card_limit" db:"yellow_card_limit" valid:"range(0|20)" example:"5"`
+	YellowCardBan   int   `json:"yellow_card_ban" db:"yellow_card_ban" valid:"range(0|20)" example:"1"`
+}
+
+// Suspension is a ban of a player from the next matches of their team in a
+// season of a competition. MatchIDs lists the banned matches already
+// scheduled.
+type Suspension struct {
+	PlayerID       int64   `json:"player_id"`
+	TeamID         int64   `json:"team_id"`
+	CompetitionID  int64   `json:"competition_id"`
+	SeasonID       *int64  `json:"season_id,omitempty"`
+	Reason         string  `json:"reason"`
+	TriggerMatchID int64   `json:"trigger_match_id"`
+	Matches        int     `json:"matches"`
+	MatchIDs       []int64 `json:"match_ids"`
+	Served         int     `json:"served"`
+	Remaining      int     `json:"remaining"`
+}
@@ -7,4 +7,5 @@ type Team struct {
 	ID          int64  `json:"id" db:"id"`
 	Name        string `json:"name" db:"name" valid:"required"`
 	Description string `json:"description" db:"description" valid:"required"`
+	VenueID     *int64 `json:"venue_id,omitempty" db:"venue_id"`
 }
@@ -0,0 +1,15 @@
+package models
+
+// Venue model.
+type Venue struct {
+	CreatedUpdated
+
+	ID        int64    `json:"id" db:"id"`
+	Name      string   `json:"name" db:"name" valid:"required"`
+	City      string   `json:"city" db:"city"`
+	Country   string   `json:"country" db:"country" valid:"ISO3166Alpha2" example:"ID"`
+	Capacity  int      `json:"capacity" db:"capacity" valid:"range(0|200000)"`
+	Surface   string   `json:"surface" db:"surface" valid:"in(grass|artificial|hybrid)"`
+	Latitude  *float64 `json:"latitude,omitempty" db:"latitude" example:"-6.218"`
+	Longitude *float64 `json:"longitude,omitempty" db:"longitude" example:"106.802"`
+}
@@ -0,0 +1,431 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// BracketsService service interface.
+type BracketsService interface {
+	GetBracket(ctx context.Context, seasonID int64) (models.Bracket, error)
+	DrawBracket(ctx context.Context, draw models.BracketDraw, force bool) (models.Bracket, error)
+}
+
+type bracketsService struct {
+	db    *sqlx.DB
+	teams TeamsService
+	rules SchedulingRules
+}
+
+// NewBracketsService returns an initialized BracketsService implementation.
+// First round ties breaking the scheduling rules fail the draw with a
+// ScheduleConflictError unless forced.
+func NewBracketsService(db *sqlx.DB, teams TeamsService, rules SchedulingRules) BracketsService {
+	return &bracketsService{db: db, teams: teams, rules: rules}
+}
+
+const bracketNodeColumns = `
+			id
+			, competition_id
+			, season_id
+			, round
+			, position
+			, home_team_id
+			, away_team_id
+			, winner_team_id
+			, match_id
+			, kickoff`
+
+func (s *bracketsService) GetBracket(ctx context.Context, seasonID int64) (models.Bracket, error) {
+	query := `SELECT` + bracketNodeColumns + `
+		FROM bracket_nodes
+		WHERE season_id = $1
+		ORDER BY round, position`
+
+	var nodes []models.BracketNode
+	if err := s.db.SelectContext(ctx, &nodes, query, seasonID); err != nil {
+		return models.Bracket{}, fmt.Errorf("get the list of bracket nodes: %s", err)
+	}
+	if len(nodes) == 0 {
+		return models.Bracket{}, fmt.Errorf("get a bracket: %s", sql.ErrNoRows)
+	}
+
+	return buildBracket(nodes[0].CompetitionID, seasonID, nodes), nil
+}
+
+func (s *bracketsService) DrawBracket(ctx context.Context, draw models.BracketDraw, force bool) (models.Bracket, error) {
+	if draw.DaysBetweenRounds == 0 {
+		draw.DaysBetweenRounds = defaultDaysBetweenRounds
+	}
+
+	teams, err := s.teams.ListTeams(ctx)
+	if err != nil {
+		return models.Bracket{}, fmt.Errorf("get the list of teams: %s", err)
+	}
+	if err := validateDraw(draw.TeamIDs, teams); err != nil {
+		return models.Bracket{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Bracket{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var season struct {
+		ID              int64  `db:"id"`
+		CompetitionID   int64  `db:"competition_id"`
+		CompetitionType string `db:"type"`
+	}
+	seasonQuery := `
+		SELECT
+			s.id
+			, s.competition_id
+			, c.type
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id
+		WHERE s.id = $1
+		FOR UPDATE OF s`
+	if err := tx.GetContext(ctx, &season, seasonQuery, draw.SeasonID); err != nil {
+		return models.Bracket{}, fmt.Errorf("get the season: %s", err)
+	}
+	if season.CompetitionType != models.CompetitionCup {
+		return models.Bracket{}, NewValidationError(fmt.Sprintf("competition %d is not a cup", season.CompetitionID))
+	}
+
+	var drawn int
+	if err := tx.GetContext(ctx, &drawn, `SELECT COUNT(*) FROM bracket_nodes WHERE season_id = $1`, season.ID); err != nil {
+		return models.Bracket{}, fmt.Errorf("count bracket nodes: %s", err)
+	}
+	if drawn > 0 {
+		return models.Bracket{}, NewConflictError(fmt.Sprintf("season %d already has a bracket", season.ID))
+	}
+
+	homeVenues, err := lockSchedule(ctx, tx, draw.TeamIDs)
+	if err != nil {
+		return models.Bracket{}, err
+	}
+
+	query := `
+		INSERT INTO bracket_nodes (competition_id, season_id, round, position, home_team_id, away_team_id,
+			winner_team_id, kickoff)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	var conflicts []models.Conflict
+
+	for _, node := range drawNodes(draw.TeamIDs) {
+		node.CompetitionID = season.CompetitionID
+		node.SeasonID = &season.ID
+		node.Kickoff = draw.FirstKickoff.AddDate(0, 0, (node.Round-1)*draw.DaysBetweenRounds)
+		if err := tx.QueryRowxContext(ctx, query, node.CompetitionID, node.SeasonID, node.Round, node.Position,
+			node.HomeTeamID, node.AwayTeamID, node.WinnerTeamID, node.Kickoff).Scan(&node.ID); err != nil {
+			return models.Bracket{}, fmt.Errorf("insert bracket node: %s", err)
+		}
+
+		if node.HomeTeamID != nil && node.AwayTeamID != nil && node.WinnerTeamID == nil {
+			found, err := scheduleConflicts(ctx, tx, tieMatch(node, homeVenues[*node.HomeTeamID]), s.rules)
+			if err != nil {
+				return models.Bracket{}, err
+			}
+			conflicts = append(conflicts, found...)
+
+			if err := scheduleTie(ctx, tx, node); err != nil {
+				return models.Bracket{}, err
+			}
+		}
+	}
+	if len(conflicts) > 0 && !force {
+		return models.Bracket{}, &ScheduleConflictError{Conflicts: conflicts}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Bracket{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	bracket, err := s.GetBracket(ctx, season.ID)
+	if err != nil {
+		return models.Bracket{}, err
+	}
+	bracket.Conflicts = conflicts
+
+	return bracket, nil
+}
+
+// validateDraw checks that at least two distinct, existing teams enter a
+// bracket.
+func validateDraw(teamIDs []int64, teams []models.Team) error {
+	var problems []string
+
+	if len(teamIDs) < 2 {
+		problems = append(problems, fmt.Sprintf("a bracket needs at least 2 teams, got %d", len(teamIDs)))
+	}
+
+	known := make(map[int64]bool, len(teams))
+	for _, team := range teams {
+		known[team.ID] = true
+	}
+	drawn := make(map[int64]bool, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if drawn[teamID] {
+			problems = append(problems, fmt.Sprintf("team %d is drawn more than once", teamID))
+			continue
+		}
+		drawn[teamID] = true
+
+		if !known[teamID] {
+			problems = append(problems, fmt.Sprintf("team %d does not exist", teamID))
+		}
+	}
+
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+	return nil
+}
+
+// seedOrder returns the seeds, from 1, in the order of the slots of a
+// bracket of the given power of two size, so that the best seeds meet as
+// late as possible: 1 faces the last seed, and 1 and 2 can only meet in
+// the final.
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, 2*len(order))
+		for _, seed := range order {
+			next = append(next, seed, 2*len(order)+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// drawNodes lays out the ties of a bracket for the given teams, best seed
+// first. The bracket is sized to the next power of two, the missing seeds
+// being byes: the best seeds get them and go straight to the second round.
+func drawNodes(teamIDs []int64) []models.BracketNode {
+	size, rounds := 1, 0
+	for size < len(teamIDs) {
+		size *= 2
+		rounds++
+	}
+
+	var nodes []models.BracketNode
+	index := make(map[[2]int]int)
+	for round := 1; round <= rounds; round++ {
+		for position := 0; position < size>>uint(round); position++ {
+			index[[2]int{round, position}] = len(nodes)
+			nodes = append(nodes, models.BracketNode{Round: round, Position: position})
+		}
+	}
+
+	team := func(seed int) *int64 {
+		if seed > len(teamIDs) {
+			return nil
+		}
+		id := teamIDs[seed-1]
+		return &id
+	}
+
+	order := seedOrder(size)
+	for position := 0; position < size/2; position++ {
+		node := &nodes[index[[2]int{1, position}]]
+		node.HomeTeamID = team(order[2*position])
+		node.AwayTeamID = team(order[2*position+1])
+
+		if node.AwayTeamID == nil {
+			node.WinnerTeamID = node.HomeTeamID
+			next := &nodes[index[[2]int{2, position / 2}]]
+			if position%2 == 0 {
+				next.HomeTeamID = node.HomeTeamID
+			} else {
+				next.AwayTeamID = node.HomeTeamID
+			}
+		}
+	}
+
+	return nodes
+}
+
+// knockoutWinner returns the team that won a knockout match, on the score
+// or else on penalties, and whether the match has a winner.
+func knockoutWinner(match models.Match) (int64, bool) {
+	home, away := match.HomeScore, match.AwayScore
+	if home == away && match.HomePenalties != nil && match.AwayPenalties != nil {
+		home, away = *match.HomePenalties, *match.AwayPenalties
+	}
+
+	switch {
+	case home > away:
+		return match.HomeTeamID, true
+	case away > home:
+		return match.AwayTeamID, true
+	default:
+		return 0, false
+	}
+}
+
+// tieWinner returns the winner of the finished match of a bracket tie, and
+// whether it differs from the winner the tie had so far, as it does when a
+// result is corrected.
+func tieWinner(node models.BracketNode, match models.Match) (int64, bool, error) {
+	winner, ok := knockoutWinner(match)
+	if !ok {
+		return 0, false, NewValidationError(fmt.Sprintf("knockout match %d finished level: set its penalty shoot-out score", match.ID))
+	}
+
+	return winner, node.WinnerTeamID == nil || *node.WinnerTeamID != winner, nil
+}
+
+// buildBracket assembles the nodes of a bracket into the tree of ties
+// leading to the final.
+func buildBracket(competitionID, seasonID int64, nodes []models.BracketNode) models.Bracket {
+	rounds := 0
+	byPosition := make(map[[2]int]models.BracketNode, len(nodes))
+	for _, node := range nodes {
+		byPosition[[2]int{node.Round, node.Position}] = node
+		if node.Round > rounds {
+			rounds = node.Round
+		}
+	}
+
+	var build func(round, position int) models.BracketNode
+	build = func(round, position int) models.BracketNode {
+		node := byPosition[[2]int{round, position}]
+		if round > 1 {
+			node.Children = []models.BracketNode{build(round-1, 2*position), build(round-1, 2*position+1)}
+		}
+		return node
+	}
+
+	return models.Bracket{CompetitionID: competitionID, SeasonID: seasonID, Rounds: rounds, Final: build(rounds, 0)}
+}
+
+// tieMatch returns the match of a bracket tie, played at the given home
+// venue.
+func tieMatch(node models.BracketNode, venueID *int64) models.Match {
+	return models.Match{
+		CompetitionID: &node.CompetitionID,
+		SeasonID:      node.SeasonID,
+		HomeTeamID:    *node.HomeTeamID,
+		AwayTeamID:    *node.AwayTeamID,
+		Kickoff:       node.Kickoff,
+		VenueID:       venueID,
+		Status:        models.MatchScheduled,
+	}
+}
+
+// scheduleTie creates the match of a bracket tie whose teams are known.
+func scheduleTie(ctx context.Context, tx *sqlx.Tx, node models.BracketNode) error {
+	query := `
+		INSERT INTO matches (competition_id, season_id, home_team_id, away_team_id, kickoff, venue_id, status)
+		VALUES ($1, $2, $3, $4, $5, (SELECT venue_id FROM teams WHERE id = $3), $6)
+		RETURNING id`
+
+	var matchID int64
+	if err := tx.QueryRowxContext(ctx, query, node.CompetitionID, node.SeasonID, node.HomeTeamID, node.AwayTeamID,
+		node.Kickoff, models.MatchScheduled).Scan(&matchID); err != nil {
+		return fmt.Errorf("insert bracket match: %s", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE bracket_nodes SET match_id = $1 WHERE id = $2`, matchID, node.ID); err != nil {
+		return fmt.Errorf("link bracket match: %s", err)
+	}
+
+	return nil
+}
+
+// advanceBracket moves the winner of a finished match on to the next round
+// when the match is a bracket tie, scheduling the next tie once both of its
+// teams are known. A tie finishing level needs its penalty shoot-out score.
+func advanceBracket(ctx context.Context, tx *sqlx.Tx, matchID int64) error {
+	var node models.BracketNode
+	query := `SELECT` + bracketNodeColumns + ` FROM bracket_nodes WHERE match_id = $1 FOR UPDATE`
+	err := tx.GetContext(ctx, &node, query, matchID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get the bracket node: %s", err)
+	}
+
+	var match models.Match
+	matchQuery := `
+		SELECT
+			id
+			, home_team_id
+			, away_team_id
+			, home_score
+			, away_score
+			, home_penalties
+			, away_penalties
+		FROM matches
+		WHERE id = $1`
+	if err := tx.GetContext(ctx, &match, matchQuery, matchID); err != nil {
+		return fmt.Errorf("get the bracket match: %s", err)
+	}
+
+	winner, changed, err := tieWinner(node, match)
+	if err != nil || !changed {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE bracket_nodes SET winner_team_id = $1 WHERE id = $2`, winner, node.ID); err != nil {
+		return fmt.Errorf("set the bracket winner: %s", err)
+	}
+
+	var next models.BracketNode
+	nextQuery := `SELECT` + bracketNodeColumns + `
+		FROM bracket_nodes
+		WHERE competition_id = $1 AND season_id IS NOT DISTINCT FROM $2 AND round = $3 AND position = $4
+		FOR UPDATE`
+	err = tx.GetContext(ctx, &next, nextQuery, node.CompetitionID, node.SeasonID, node.Round+1, node.Position/2)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get the next bracket node: %s", err)
+	}
+
+	slot := "home_team_id"
+	if node.Position%2 == 0 {
+		next.HomeTeamID = &winner
+	} else {
+		slot = "away_team_id"
+		next.AwayTeamID = &winner
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE bracket_nodes SET `+slot+` = $1 WHERE id = $2`, winner, next.ID); err != nil {
+		return fmt.Errorf("advance the bracket winner: %s", err)
+	}
+
+	if next.MatchID != nil {
+		// The result was corrected after the next tie was scheduled.
+		var status string
+		if err := tx.GetContext(ctx, &status, `SELECT status FROM matches WHERE id = $1`, *next.MatchID); err != nil {
+			return fmt.Errorf("get the next bracket match: %s", err)
+		}
+		if status != models.MatchScheduled {
+			return NewConflictError(fmt.Sprintf("match %d of the next round has already kicked off", *next.MatchID))
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE matches SET `+slot+` = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`,
+			winner, *next.MatchID); err != nil {
+			return fmt.Errorf("update the next bracket match: %s", err)
+		}
+		return nil
+	}
+
+	// The kickoff of the next tie was set at the draw, and a result is not
+	// held up by it: the tie is scheduled whatever its conflicts, which are
+	// left to rescheduling it.
+	if next.HomeTeamID != nil && next.AwayTeamID != nil {
+		return scheduleTie(ctx, tx, next)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestSeedOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, seedOrder(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, seedOrder(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, seedOrder(8))
+}
+
+func TestDrawNodes(t *testing.T) {
+	nodes := drawNodes([]int64{11, 12, 13, 14, 15, 16})
+
+	if assert.Len(t, nodes, 4+2+1) {
+		// Seeds 1 and 2 get byes and wait in the semi-finals.
+		assert.Equal(t, models.BracketNode{Round: 1, Position: 0, HomeTeamID: int64Ptr(11), WinnerTeamID: int64Ptr(11)}, nodes[0])
+		assert.Equal(t, models.BracketNode{Round: 1, Position: 1, HomeTeamID: int64Ptr(14), AwayTeamID: int64Ptr(15)}, nodes[1])
+		assert.Equal(t, models.BracketNode{Round: 1, Position: 2, HomeTeamID: int64Ptr(12), WinnerTeamID: int64Ptr(12)}, nodes[2])
+		assert.Equal(t, models.BracketNode{Round: 1, Position: 3, HomeTeamID: int64Ptr(13), AwayTeamID: int64Ptr(16)}, nodes[3])
+		assert.Equal(t, models.BracketNode{Round: 2, Position: 0, HomeTeamID: int64Ptr(11)}, nodes[4])
+		assert.Equal(t, models.BracketNode{Round: 2, Position: 1, HomeTeamID: int64Ptr(12)}, nodes[5])
+		assert.Equal(t, models.BracketNode{Round: 3, Position: 0}, nodes[6])
+	}
+
+	assert.Equal(t, []models.BracketNode{
+		{Round: 1, Position: 0, HomeTeamID: int64Ptr(1), AwayTeamID: int64Ptr(2)},
+	}, drawNodes([]int64{1, 2}))
+}
+
+func TestBuildBracket(t *testing.T) {
+	nodes := drawNodes([]int64{1, 2, 3})
+
+	bracket := buildBracket(7, 9, nodes)
+
+	assert.Equal(t, int64(7), bracket.CompetitionID)
+	assert.Equal(t, int64(9), bracket.SeasonID)
+	assert.Equal(t, 2, bracket.Rounds)
+	assert.Equal(t, 2, bracket.Final.Round)
+	if assert.Len(t, bracket.Final.Children, 2) {
+		assert.Equal(t, int64Ptr(1), bracket.Final.Children[0].WinnerTeamID)
+		assert.Equal(t, int64Ptr(2), bracket.Final.Children[1].HomeTeamID)
+		assert.Equal(t, int64Ptr(3), bracket.Final.Children[1].AwayTeamID)
+		assert.Empty(t, bracket.Final.Children[1].Children)
+	}
+}
+
+func TestKnockoutWinner(t *testing.T) {
+	match := models.Match{HomeTeamID: 1, AwayTeamID: 2, HomeScore: 2, AwayScore: 1}
+	winner, ok := knockoutWinner(match)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), winner)
+
+	match.AwayScore = 2
+	_, ok = knockoutWinner(match)
+	assert.False(t, ok)
+
+	match.HomePenalties, match.AwayPenalties = intPtr(3), intPtr(4)
+	winner, ok = knockoutWinner(match)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), winner)
+
+	// Penalties only count when the match finished level.
+	match.HomeScore = 3
+	winner, ok = knockoutWinner(match)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), winner)
+}
+
+func TestValidatePenalties(t *testing.T) {
+	assert.NoError(t, validatePenalties(models.Match{}))
+	assert.NoError(t, validatePenalties(models.Match{HomePenalties: intPtr(5), AwayPenalties: intPtr(4)}))
+	assert.Error(t, validatePenalties(models.Match{HomePenalties: intPtr(5)}))
+	assert.Error(t, validatePenalties(models.Match{HomePenalties: intPtr(4), AwayPenalties: intPtr(4)}))
+	assert.Error(t, validatePenalties(models.Match{HomePenalties: intPtr(-1), AwayPenalties: intPtr(4)}))
+}
+
+func TestValidateDraw(t *testing.T) {
+	teams := []models.Team{{ID: 1}, {ID: 2}}
+
+	assert.NoError(t, validateDraw([]int64{2, 1}, teams))
+	assert.Equal(t, NewValidationError(
+		"team 1 is drawn more than once",
+		"team 3 does not exist",
+	), validateDraw([]int64{1, 1, 3}, teams))
+	assert.Equal(t, NewValidationError("a bracket needs at least 2 teams, got 1"), validateDraw([]int64{1}, teams))
+}
+
+func TestTieWinner(t *testing.T) {
+	node := models.BracketNode{HomeTeamID: int64Ptr(1), AwayTeamID: int64Ptr(2)}
+	match := models.Match{ID: 5, HomeTeamID: 1, AwayTeamID: 2, HomeScore: 1, AwayScore: 0}
+
+	winner, changed, err := tieWinner(node, match)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), winner)
+	assert.True(t, changed)
+
+	node.WinnerTeamID = int64Ptr(1)
+	_, changed, err = tieWinner(node, match)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// A disallowed goal and a late away goal flip the result.
+	match.HomeScore, match.AwayScore = 0, 1
+	winner, changed, err = tieWinner(node, match)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), winner)
+	assert.True(t, changed)
+
+	match.AwayScore = 0
+	_, _, err = tieWinner(node, match)
+	assert.IsType(t, &ValidationError{}, err)
+}
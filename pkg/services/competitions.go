@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// CompetitionsService service interface.
+type CompetitionsService interface {
+	ListCompetitions(ctx context.Context) ([]models.Competition, error)
+	GetCompetition(ctx context.Context, id int64) (models.Competition, error)
+	CreateCompetition(ctx context.Context, competition models.Competition) (models.Competition, error)
+	DeleteCompetition(ctx context.Context, id int64) error
+	UpdateCompetition(ctx context.Context, competition models.Competition) (models.Competition, error)
+}
+
+type competitionsService struct {
+	db *sqlx.DB
+}
+
+// NewCompetitionsService returns an initialized CompetitionsService implementation.
+func NewCompetitionsService(db *sqlx.DB) CompetitionsService {
+	return &competitionsService{db: db}
+}
+
+func (s *competitionsService) ListCompetitions(ctx context.Context) ([]models.Competition, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, country
+			, type
+			, created_at
+			, updated_at
+		FROM competitions`
+
+	var competitions []models.Competition
+	if err := s.db.SelectContext(ctx, &competitions, query); err != nil {
+		return nil, fmt.Errorf("get the list of competitions: %s", err)
+	}
+
+	return competitions, nil
+}
+
+func (s *competitionsService) GetCompetition(ctx context.Context, id int64) (models.Competition, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, country
+			, type
+			, created_at
+			, updated_at
+		FROM competitions
+		WHERE id = $1`
+
+	var competition models.Competition
+	if err := s.db.GetContext(ctx, &competition, query, id); err != nil {
+		return models.Competition{}, fmt.Errorf("get a competition: %s", err)
+	}
+
+	return competition, nil
+}
+
+func (s *competitionsService) CreateCompetition(ctx context.Context, competition models.Competition) (models.Competition, error) {
+	if competition.Type == "" {
+		competition.Type = models.CompetitionLeague
+	}
+
+	query := "INSERT INTO competitions (name, country, type) VALUES ($1, $2, $3) RETURNING id"
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, competition.Name, competition.Country, competition.Type).Scan(&id); err != nil {
+		return models.Competition{}, fmt.Errorf("insert new competition: %s", err)
+	}
+
+	newCompetition, err := s.GetCompetition(ctx, id)
+	if err != nil {
+		return models.Competition{}, fmt.Errorf("get new competition: %s", err)
+	}
+
+	return newCompetition, nil
+}
+
+func (s *competitionsService) DeleteCompetition(ctx context.Context, id int64) error {
+	query := `DELETE FROM competitions WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("delete a competition: %s", err)
+	}
+
+	return nil
+}
+
+func (s *competitionsService) UpdateCompetition(ctx context.Context, competition models.Competition) (models.Competition, error) {
+	if competition.Type == "" {
+		competition.Type = models.CompetitionLeague
+	}
+
+	query := `UPDATE competitions SET name=$1, country=$2, type=$3, updated_at=CURRENT_TIMESTAMP WHERE id=$4`
+
+	if _, err := s.db.ExecContext(ctx, query, competition.Name, competition.Country, competition.Type, competition.ID); err != nil {
+		return models.Competition{}, fmt.Errorf("update competition: %s", err)
+	}
+
+	updatedCompetition, err := s.GetCompetition(ctx, competition.ID)
+	if err != nil {
+		return models.Competition{}, fmt.Errorf("get competition: %s", err)
+	}
+
+	return updatedCompetition, nil
+}
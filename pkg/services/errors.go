@@ -0,0 +1,62 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+
+	"soccer/pkg/models"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// ValidationError is returned when a request breaks a domain rule that
+// can only be checked against stored data.
+type ValidationError struct {
+	Problems []string
+}
+
+// NewValidationError returns a ValidationError with the given problems.
+func NewValidationError(problems ...string) *ValidationError {
+	return &ValidationError{Problems: problems}
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+// ConflictError is returned when a write conflicts with data already
+// stored, such as a jersey number worn by a team mate.
+type ConflictError struct {
+	Message string
+}
+
+// NewConflictError returns a ConflictError with the given message.
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{Message: message}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// ScheduleConflictError is returned when a match clashes with the schedule
+// of other matches and was not forced through.
+type ScheduleConflictError struct {
+	Conflicts []models.Conflict
+}
+
+func (e *ScheduleConflictError) Error() string {
+	messages := make([]string, len(e.Conflicts))
+	for i, conflict := range e.Conflicts {
+		messages[i] = conflict.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// isUniqueViolation reports whether err violates the given unique constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == uniqueViolation && pqErr.Constraint == constraint
+}
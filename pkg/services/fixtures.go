@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// defaultDaysBetweenRounds is the gap between two rounds of generated
+// fixtures when none is given.
+const defaultDaysBetweenRounds = 7
+
+// FixturesService service interface.
+type FixturesService interface {
+	GenerateFixtures(ctx context.Context, schedule models.FixtureSchedule, force bool) ([]models.Match, error)
+}
+
+type fixturesService struct {
+	db    *sqlx.DB
+	rules SchedulingRules
+}
+
+// NewFixturesService returns an initialized FixturesService implementation.
+// Fixtures breaking the scheduling rules fail with a ScheduleConflictError
+// unless forced.
+func NewFixturesService(db *sqlx.DB, rules SchedulingRules) FixturesService {
+	return &fixturesService{db: db, rules: rules}
+}
+
+// fixture is a pairing of two teams in a round.
+type fixture struct {
+	home, away int64
+}
+
+func (s *fixturesService) GenerateFixtures(ctx context.Context, schedule models.FixtureSchedule, force bool) ([]models.Match, error) {
+	if schedule.DaysBetweenRounds == 0 {
+		schedule.DaysBetweenRounds = defaultDaysBetweenRounds
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var season models.Season
+	seasonQuery := `SELECT id, competition_id FROM seasons WHERE id = $1 FOR UPDATE`
+	if err := tx.GetContext(ctx, &season, seasonQuery, schedule.SeasonID); err != nil {
+		return nil, fmt.Errorf("get the season: %s", err)
+	}
+
+	var scheduled int
+	if err := tx.GetContext(ctx, &scheduled, `SELECT COUNT(*) FROM matches WHERE season_id = $1`, season.ID); err != nil {
+		return nil, fmt.Errorf("count season matches: %s", err)
+	}
+	if scheduled > 0 {
+		return nil, NewConflictError(fmt.Sprintf("season %d already has %d matches", season.ID, scheduled))
+	}
+
+	var teamIDs []int64
+	teamsQuery := `SELECT team_id FROM season_teams WHERE season_id = $1 ORDER BY team_id`
+	if err := tx.SelectContext(ctx, &teamIDs, teamsQuery, season.ID); err != nil {
+		return nil, fmt.Errorf("get the season teams: %s", err)
+	}
+	if len(teamIDs) < 2 {
+		return nil, NewValidationError(fmt.Sprintf("season %d needs at least 2 registered teams, got %d", season.ID, len(teamIDs)))
+	}
+
+	homeVenues, err := lockSchedule(ctx, tx, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO matches (competition_id, season_id, home_team_id, away_team_id, kickoff, venue_id, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`
+
+	// Every fixture is checked against the matches stored, the ones
+	// generated before it included, so that all the conflicts are reported
+	// at once.
+	var conflicts []models.Conflict
+	conflictsByMatch := make(map[int64][]models.Conflict)
+	for round, fixtures := range roundRobin(teamIDs, schedule.Double) {
+		kickoff := schedule.FirstKickoff.AddDate(0, 0, round*schedule.DaysBetweenRounds)
+		for _, f := range fixtures {
+			match := models.Match{
+				CompetitionID: &season.CompetitionID,
+				SeasonID:      &season.ID,
+				HomeTeamID:    f.home,
+				AwayTeamID:    f.away,
+				Kickoff:       kickoff,
+				VenueID:       homeVenues[f.home],
+				Status:        models.MatchScheduled,
+			}
+			found, err := scheduleConflicts(ctx, tx, match, s.rules)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := tx.QueryRowxContext(ctx, query, match.CompetitionID, match.SeasonID, match.HomeTeamID, match.AwayTeamID,
+				match.Kickoff, match.VenueID, match.Status).Scan(&match.ID); err != nil {
+				return nil, fmt.Errorf("insert new match: %s", err)
+			}
+			if len(found) > 0 {
+				conflicts = append(conflicts, found...)
+				conflictsByMatch[match.ID] = found
+			}
+		}
+	}
+	if len(conflicts) > 0 && !force {
+		return nil, &ScheduleConflictError{Conflicts: conflicts}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	matchesQuery := `
+		SELECT
+			id
+			, competition_id
+			, season_id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+			, home_score
+			, away_score
+			, created_at
+			, updated_at
+		FROM matches
+		WHERE season_id = $1
+		ORDER BY kickoff, id`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, matchesQuery, season.ID); err != nil {
+		return nil, fmt.Errorf("get the list of generated matches: %s", err)
+	}
+	for i := range matches {
+		matches[i].Conflicts = conflictsByMatch[matches[i].ID]
+	}
+
+	return matches, nil
+}
+
+// roundRobin schedules every team against every other team using the
+// circle method: the first team stays in place while the others rotate
+// around it, and each round pairs the teams facing each other. With an odd
+// number of teams, the bye takes the fixed place and whoever faces it rests
+// that round. Home and away alternate from round to round for every team,
+// around its bye with an odd number of teams and with one break at most for
+// all but two teams otherwise, and in a double round-robin the second half
+// repeats the first with venues swapped.
+func roundRobin(teamIDs []int64, double bool) [][]fixture {
+	const bye = 0
+
+	circle := append([]int64{}, teamIDs...)
+	if len(circle)%2 == 1 {
+		circle = append([]int64{bye}, circle...)
+	}
+	n := len(circle)
+
+	rounds := make([][]fixture, 0, 2*(n-1))
+	for round := 0; round < n-1; round++ {
+		fixtures := make([]fixture, 0, n/2)
+		for i := 0; i < n/2; i++ {
+			f := fixture{home: circle[i], away: circle[n-1-i]}
+			if (i == 0 && round%2 == 1) || (i > 0 && i%2 == 1) {
+				f.home, f.away = f.away, f.home
+			}
+			if f.home != bye && f.away != bye {
+				fixtures = append(fixtures, f)
+			}
+		}
+		rounds = append(rounds, fixtures)
+
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+
+	if double {
+		for _, fixtures := range rounds[:n-1] {
+			reversed := make([]fixture, len(fixtures))
+			for i, f := range fixtures {
+				reversed[i] = fixture{home: f.away, away: f.home}
+			}
+			rounds = append(rounds, reversed)
+		}
+	}
+
+	return rounds
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundRobin(t *testing.T) {
+	for _, teams := range []int{2, 3, 4, 5, 6, 7, 18, 19, 20} {
+		var teamIDs []int64
+		for id := int64(1); id <= int64(teams); id++ {
+			teamIDs = append(teamIDs, id)
+		}
+
+		rounds := roundRobin(teamIDs, false)
+
+		expectedRounds := teams - 1
+		if teams%2 == 1 {
+			expectedRounds = teams
+		}
+		assert.Len(t, rounds, expectedRounds, "%d teams", teams)
+
+		met := make(map[[2]int64]int)
+		home := make(map[int64]int)
+		venues := make(map[int64]string)
+		for _, fixtures := range rounds {
+			played := make(map[int64]bool)
+			for _, f := range fixtures {
+				assert.False(t, played[f.home] || played[f.away], "%d teams: team plays twice in a round", teams)
+				played[f.home], played[f.away] = true, true
+
+				pair := [2]int64{f.home, f.away}
+				if pair[0] > pair[1] {
+					pair[0], pair[1] = pair[1], pair[0]
+				}
+				met[pair]++
+				home[f.home]++
+				venues[f.home] += "H"
+				venues[f.away] += "A"
+			}
+			assert.Len(t, fixtures, teams/2, "%d teams", teams)
+		}
+
+		assert.Len(t, met, teams*(teams-1)/2, "%d teams", teams)
+		for pair, count := range met {
+			assert.Equal(t, 1, count, "%d teams: %v", teams, pair)
+		}
+		breaks := 0
+		for _, id := range teamIDs {
+			assert.InDelta(t, float64(teams-1)/2, home[id], 1, "%d teams: home games of team %d", teams, id)
+
+			run := 1
+			for i := 1; i < len(venues[id]); i++ {
+				if venues[id][i] != venues[id][i-1] {
+					run = 1
+					continue
+				}
+				run++
+				breaks++
+				assert.LessOrEqual(t, run, 2, "%d teams: team %d plays %s", teams, id, venues[id])
+			}
+		}
+		// Byes let every team alternate; otherwise two teams at least must
+		// break the alternation, and the circle method breaks it for all
+		// but two teams once.
+		if teams%2 == 1 {
+			assert.Equal(t, 0, breaks, "%d teams", teams)
+		} else {
+			assert.Equal(t, teams-2, breaks, "%d teams", teams)
+		}
+	}
+}
+
+func TestRoundRobinDouble(t *testing.T) {
+	rounds := roundRobin([]int64{1, 2, 3, 4}, true)
+
+	assert.Len(t, rounds, 6)
+	for i := 0; i < 3; i++ {
+		for j, f := range rounds[i] {
+			assert.Equal(t, fixture{home: f.away, away: f.home}, rounds[i+3][j])
+		}
+	}
+}
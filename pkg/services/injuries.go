@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// InjuriesService service interface.
+type InjuriesService interface {
+	ListPlayerInjuries(ctx context.Context, playerID int64) ([]models.Injury, error)
+	CreateInjury(ctx context.Context, injury models.Injury) (models.Injury, error)
+	DeleteInjury(ctx context.Context, playerID, id int64) error
+	UpdateInjury(ctx context.Context, injury models.Injury) (models.Injury, error)
+	GetTeamAvailability(ctx context.Context, teamID int64, date time.Time) (models.TeamAvailability, error)
+}
+
+type injuriesService struct {
+	db          *sqlx.DB
+	players     PlayersService
+	suspensions SuspensionsService
+}
+
+// NewInjuriesService returns an initialized InjuriesService implementation.
+func NewInjuriesService(db *sqlx.DB, players PlayersService, suspensions SuspensionsService) InjuriesService {
+	return &injuriesService{db: db, players: players, suspensions: suspensions}
+}
+
+func (s *injuriesService) ListPlayerInjuries(ctx context.Context, playerID int64) ([]models.Injury, error) {
+	query := `
+		SELECT
+			id
+			, player_id
+			, type
+			, start_date
+			, expected_return
+			, actual_return
+			, created_at
+			, updated_at
+		FROM injuries
+		WHERE player_id = $1
+		ORDER BY start_date DESC`
+
+	var injuries []models.Injury
+	if err := s.db.SelectContext(ctx, &injuries, query, playerID); err != nil {
+		return nil, fmt.Errorf("get the list of injuries: %s", err)
+	}
+
+	return injuries, nil
+}
+
+func (s *injuriesService) getInjury(ctx context.Context, playerID, id int64) (models.Injury, error) {
+	query := `
+		SELECT
+			id
+			, player_id
+			, type
+			, start_date
+			, expected_return
+			, actual_return
+			, created_at
+			, updated_at
+		FROM injuries
+		WHERE player_id = $1 AND id = $2`
+
+	var injury models.Injury
+	if err := s.db.GetContext(ctx, &injury, query, playerID, id); err != nil {
+		return models.Injury{}, fmt.Errorf("get an injury: %s", err)
+	}
+
+	return injury, nil
+}
+
+func (s *injuriesService) CreateInjury(ctx context.Context, injury models.Injury) (models.Injury, error) {
+	query := `
+		INSERT INTO injuries (player_id, type, start_date, expected_return, actual_return)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, injury.PlayerID, injury.Type, injury.StartDate,
+		injury.ExpectedReturn, injury.ActualReturn).Scan(&id); err != nil {
+		return models.Injury{}, fmt.Errorf("insert new injury: %s", err)
+	}
+
+	newInjury, err := s.getInjury(ctx, injury.PlayerID, id)
+	if err != nil {
+		return models.Injury{}, fmt.Errorf("get new injury: %s", err)
+	}
+
+	return newInjury, nil
+}
+
+func (s *injuriesService) DeleteInjury(ctx context.Context, playerID, id int64) error {
+	query := `DELETE FROM injuries WHERE player_id = $1 AND id = $2`
+
+	if _, err := s.db.ExecContext(ctx, query, playerID, id); err != nil {
+		return fmt.Errorf("delete an injury: %s", err)
+	}
+
+	return nil
+}
+
+func (s *injuriesService) UpdateInjury(ctx context.Context, injury models.Injury) (models.Injury, error) {
+	query := `
+		UPDATE injuries SET
+			type=$1
+			, start_date=$2
+			, expected_return=$3
+			, actual_return=$4
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE player_id=$5 AND id=$6`
+
+	if _, err := s.db.ExecContext(ctx, query, injury.Type, injury.StartDate, injury.ExpectedReturn, injury.ActualReturn,
+		injury.PlayerID, injury.ID); err != nil {
+		return models.Injury{}, fmt.Errorf("update injury: %s", err)
+	}
+
+	updatedInjury, err := s.getInjury(ctx, injury.PlayerID, injury.ID)
+	if err != nil {
+		return models.Injury{}, fmt.Errorf("get injury: %s", err)
+	}
+
+	return updatedInjury, nil
+}
+
+func (s *injuriesService) GetTeamAvailability(ctx context.Context, teamID int64, date time.Time) (models.TeamAvailability, error) {
+	players, err := s.players.ListPlayersByTeams(ctx, teamID, models.PlayerFilter{})
+	if err != nil {
+		return models.TeamAvailability{}, fmt.Errorf("get the team players: %s", err)
+	}
+
+	injuredQuery := `
+		SELECT DISTINCT i.player_id
+		FROM injuries i
+		JOIN players p ON p.id = i.player_id
+		WHERE p.team_id = $1
+			AND i.start_date <= $2
+			AND (i.actual_return IS NULL OR i.actual_return > $2)`
+
+	var injured []int64
+	if err := s.db.SelectContext(ctx, &injured, injuredQuery, teamID, date); err != nil {
+		return models.TeamAvailability{}, fmt.Errorf("get the injured players: %s", err)
+	}
+
+	var suspended []int64
+	nextMatchID, err := nextTeamMatch(ctx, s.db, teamID, date)
+	if err != nil {
+		return models.TeamAvailability{}, err
+	}
+	if nextMatchID != nil {
+		suspended, err = s.suspensions.ListSuspendedPlayers(ctx, *nextMatchID, teamID)
+		if err != nil {
+			return models.TeamAvailability{}, fmt.Errorf("get the suspended players: %s", err)
+		}
+	}
+
+	availability := teamAvailability(players, injured, suspended)
+	availability.TeamID = teamID
+	availability.Date = date
+	availability.NextMatchID = nextMatchID
+
+	return availability, nil
+}
+
+// nextTeamMatch returns the first match the team is scheduled to play from
+// the date on, or nil when there is none.
+func nextTeamMatch(ctx context.Context, db *sqlx.DB, teamID int64, date time.Time) (*int64, error) {
+	query := `
+		SELECT id
+		FROM matches
+		WHERE (home_team_id = $1 OR away_team_id = $1) AND status = $2 AND kickoff >= $3
+		ORDER BY kickoff
+		LIMIT 1`
+
+	var matchID int64
+	err := db.GetContext(ctx, &matchID, query, teamID, models.MatchScheduled, date)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("get the next match: %s", err)
+	}
+
+	return &matchID, nil
+}
+
+// teamAvailability sorts players by availability, injuries coming first.
+func teamAvailability(players []models.Player, injured, suspended []int64) models.TeamAvailability {
+	isInjured := make(map[int64]bool, len(injured))
+	for _, playerID := range injured {
+		isInjured[playerID] = true
+	}
+	isSuspended := make(map[int64]bool, len(suspended))
+	for _, playerID := range suspended {
+		isSuspended[playerID] = true
+	}
+
+	availability := models.TeamAvailability{
+		Injured:   []models.Player{},
+		Suspended: []models.Player{},
+		Fit:       []models.Player{},
+	}
+	for _, player := range players {
+		switch {
+		case isInjured[player.ID]:
+			player.Availability = models.AvailabilityInjured
+			availability.Injured = append(availability.Injured, player)
+		case isSuspended[player.ID]:
+			player.Availability = models.AvailabilitySuspended
+			availability.Suspended = append(availability.Suspended, player)
+		default:
+			player.Availability = models.AvailabilityFit
+			availability.Fit = append(availability.Fit, player)
+		}
+	}
+
+	return availability
+}
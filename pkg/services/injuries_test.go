@@ -0,0 +1,22 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestTeamAvailability(t *testing.T) {
+	players := []models.Player{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	availability := teamAvailability(players, []int64{1, 3}, []int64{2, 3})
+
+	assert.Equal(t, []models.Player{
+		{ID: 1, Availability: models.AvailabilityInjured},
+		{ID: 3, Availability: models.AvailabilityInjured},
+	}, availability.Injured)
+	assert.Equal(t, []models.Player{{ID: 2, Availability: models.AvailabilitySuspended}}, availability.Suspended)
+	assert.Equal(t, []models.Player{{ID: 4, Availability: models.AvailabilityFit}}, availability.Fit)
+}
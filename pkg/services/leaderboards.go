@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// cleanSheetMinutes is the time a goalkeeper must spend on the pitch in a
+// match without conceding to be credited with a clean sheet.
+const cleanSheetMinutes = 60
+
+func (s *statsService) GetLeaderboard(ctx context.Context, seasonID int64, category string, page models.Pagination) (models.Leaderboard, error) {
+	filter := models.StatsFilter{SeasonID: &seasonID}
+
+	events, err := s.listEvents(ctx, conditions{}, filter)
+	if err != nil {
+		return models.Leaderboard{}, err
+	}
+
+	lineups, err := s.listLineupEntries(ctx, conditions{}, filter)
+	if err != nil {
+		return models.Leaderboard{}, err
+	}
+
+	var (
+		matches     []models.Match
+		goalkeepers = make(map[int64]bool)
+	)
+	if category == models.LeaderboardCleanSheets {
+		matchesQuery := `
+			SELECT
+				id
+				, home_team_id
+				, away_team_id
+				, status
+				, home_score
+				, away_score
+			FROM matches
+			WHERE season_id = $1 AND status = $2`
+
+		if err := s.db.SelectContext(ctx, &matches, matchesQuery, seasonID, models.MatchFinished); err != nil {
+			return models.Leaderboard{}, fmt.Errorf("get the list of finished matches: %s", err)
+		}
+
+		var goalkeeperIDs []int64
+		goalkeepersQuery := `SELECT id FROM players WHERE position = $1`
+		if err := s.db.SelectContext(ctx, &goalkeeperIDs, goalkeepersQuery, models.PositionGoalkeeper); err != nil {
+			return models.Leaderboard{}, fmt.Errorf("get the list of goalkeepers: %s", err)
+		}
+		for _, id := range goalkeeperIDs {
+			goalkeepers[id] = true
+		}
+	}
+
+	entries := rankLeaderboard(category, events, lineups, matches, goalkeepers)
+
+	leaderboard := models.Leaderboard{
+		SeasonID: seasonID,
+		Category: category,
+		Page:     page.Page,
+		PerPage:  page.PerPage,
+		Total:    len(entries),
+		Entries:  []models.LeaderboardEntry{},
+	}
+
+	from, to := pageBounds(len(entries), page)
+	if from == to {
+		return leaderboard, nil
+	}
+	leaderboard.Entries = entries[from:to]
+
+	if err := s.nameLeaderboardEntries(ctx, leaderboard.Entries); err != nil {
+		return models.Leaderboard{}, err
+	}
+
+	return leaderboard, nil
+}
+
+// nameLeaderboardEntries fills in the player and team names of the entries.
+func (s *statsService) nameLeaderboardEntries(ctx context.Context, entries []models.LeaderboardEntry) error {
+	playerIDs := make([]int64, len(entries))
+	teamIDs := make([]int64, len(entries))
+	for i, entry := range entries {
+		playerIDs[i] = entry.PlayerID
+		teamIDs[i] = entry.TeamID
+	}
+
+	query, args, err := sqlx.In(`SELECT id, name FROM players WHERE id IN (?)`, playerIDs)
+	if err != nil {
+		return fmt.Errorf("build the players query: %s", err)
+	}
+	var players []models.Player
+	if err := s.db.SelectContext(ctx, &players, s.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("get the leaderboard players: %s", err)
+	}
+
+	query, args, err = sqlx.In(`SELECT id, name FROM teams WHERE id IN (?)`, teamIDs)
+	if err != nil {
+		return fmt.Errorf("build the teams query: %s", err)
+	}
+	var teams []models.Team
+	if err := s.db.SelectContext(ctx, &teams, s.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("get the leaderboard teams: %s", err)
+	}
+
+	playerNames := make(map[int64]string, len(players))
+	for _, player := range players {
+		playerNames[player.ID] = player.Name
+	}
+	teamNames := make(map[int64]string, len(teams))
+	for _, team := range teams {
+		teamNames[team.ID] = team.Name
+	}
+	for i := range entries {
+		entries[i].PlayerName = playerNames[entries[i].PlayerID]
+		entries[i].TeamName = teamNames[entries[i].TeamID]
+	}
+
+	return nil
+}
+
+// pageBounds returns the bounds of a page out of total items, empty past
+// the last page. The page is checked against the number of pages before
+// being multiplied so that huge page numbers cannot overflow.
+func pageBounds(total int, page models.Pagination) (from, to int) {
+	if page.Page < 1 || page.PerPage < 1 || page.Page-1 >= (total+page.PerPage-1)/page.PerPage {
+		return total, total
+	}
+
+	from = (page.Page - 1) * page.PerPage
+	to = from + page.PerPage
+	if to > total {
+		to = total
+	}
+	return from, to
+}
+
+// rankLeaderboard ranks the players of a season in a category, leaving out
+// those who have nothing to show for it. Players level on value are
+// ordered by fewer minutes played. Clean sheets are only counted for
+// goalkeepers, in finished matches their team did not concede in while
+// they played at least cleanSheetMinutes. Each player is listed with the
+// team they last played for.
+func rankLeaderboard(category string, events []models.MatchEvent, lineups []lineupEntry, matches []models.Match, goalkeepers map[int64]bool) []models.LeaderboardEntry {
+	var playerIDs []int64
+	playerEvents := make(map[int64][]models.MatchEvent)
+	playerLineups := make(map[int64][]lineupEntry)
+	matchTeams := make(map[int64]map[int64]int64)
+	lastTeams := make(map[int64]int64)
+	lastMatches := make(map[int64]int64)
+
+	playedFor := func(playerID, matchID, teamID int64) {
+		if _, ok := matchTeams[playerID]; !ok {
+			playerIDs = append(playerIDs, playerID)
+			matchTeams[playerID] = make(map[int64]int64)
+		}
+		matchTeams[playerID][matchID] = teamID
+		if matchID >= lastMatches[playerID] {
+			lastMatches[playerID] = matchID
+			lastTeams[playerID] = teamID
+		}
+	}
+	for _, entry := range lineups {
+		playerLineups[entry.PlayerID] = append(playerLineups[entry.PlayerID], entry)
+		playedFor(entry.PlayerID, entry.MatchID, entry.TeamID)
+	}
+	for _, event := range events {
+		playerEvents[event.PlayerID] = append(playerEvents[event.PlayerID], event)
+		playedFor(event.PlayerID, event.MatchID, event.TeamID)
+		if event.RelatedPlayerID != nil && *event.RelatedPlayerID != event.PlayerID {
+			playerEvents[*event.RelatedPlayerID] = append(playerEvents[*event.RelatedPlayerID], event)
+			playedFor(*event.RelatedPlayerID, event.MatchID, event.TeamID)
+		}
+	}
+
+	finished := make(map[int64]models.Match, len(matches))
+	for _, match := range matches {
+		if match.Status == models.MatchFinished {
+			finished[match.ID] = match
+		}
+	}
+
+	entries := []models.LeaderboardEntry{}
+	for _, playerID := range playerIDs {
+		stats := aggregatePlayerStats(playerID, playerEvents[playerID], playerLineups[playerID])
+
+		var value int
+		switch category {
+		case models.LeaderboardGoals:
+			value = stats.Goals
+		case models.LeaderboardAssists:
+			value = stats.Assists
+		case models.LeaderboardCards:
+			value = stats.YellowCards + stats.RedCards
+		case models.LeaderboardCleanSheets:
+			if !goalkeepers[playerID] {
+				continue
+			}
+			for matchID, played := range playerSpells(playerID, playerEvents[playerID], playerLineups[playerID]) {
+				match, ok := finished[matchID]
+				if !ok || !played.appeared || played.off-played.on < cleanSheetMinutes {
+					continue
+				}
+				if _, conceded := match.ScoreFor(matchTeams[playerID][matchID]); conceded == 0 {
+					value++
+				}
+			}
+		}
+		if value == 0 {
+			continue
+		}
+
+		entries = append(entries, models.LeaderboardEntry{
+			PlayerID:    playerID,
+			TeamID:      lastTeams[playerID],
+			Value:       value,
+			Appearances: stats.Appearances,
+			Minutes:     stats.Minutes,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Value != b.Value {
+			return a.Value > b.Value
+		}
+		if a.Minutes != b.Minutes {
+			return a.Minutes < b.Minutes
+		}
+		return a.PlayerID < b.PlayerID
+	})
+
+	for i := range entries {
+		entries[i].Rank = i + 1
+		if i > 0 && entries[i].Value == entries[i-1].Value && entries[i].Minutes == entries[i-1].Minutes {
+			entries[i].Rank = entries[i-1].Rank
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestRankLeaderboard(t *testing.T) {
+	events := []models.MatchEvent{
+		{MatchID: 1, TeamID: 1, PlayerID: 10, RelatedPlayerID: int64Ptr(11), Type: models.EventGoal, Minute: 10},
+		{MatchID: 1, TeamID: 1, PlayerID: 12, RelatedPlayerID: int64Ptr(10), Type: models.EventSubstitution, Minute: 45},
+		{MatchID: 1, TeamID: 1, PlayerID: 12, Type: models.EventGoal, Minute: 80},
+		{MatchID: 1, TeamID: 1, PlayerID: 11, Type: models.EventYellowCard, Minute: 85},
+		{MatchID: 2, TeamID: 2, PlayerID: 13, Type: models.EventGoal, Minute: 30},
+	}
+
+	assert.Equal(t, []models.LeaderboardEntry{
+		{Rank: 1, PlayerID: 10, TeamID: 1, Value: 1, Appearances: 1, Minutes: 45},
+		{Rank: 1, PlayerID: 12, TeamID: 1, Value: 1, Appearances: 1, Minutes: 45},
+		{Rank: 3, PlayerID: 13, TeamID: 2, Value: 1, Appearances: 1, Minutes: 90},
+	}, rankLeaderboard(models.LeaderboardGoals, events, nil, nil, nil))
+
+	assert.Equal(t, []models.LeaderboardEntry{
+		{Rank: 1, PlayerID: 11, TeamID: 1, Value: 1, Appearances: 1, Minutes: 90},
+	}, rankLeaderboard(models.LeaderboardCards, events, nil, nil, nil))
+}
+
+func TestRankLeaderboard_cleanSheets(t *testing.T) {
+	matches := []models.Match{
+		{ID: 1, HomeTeamID: 1, AwayTeamID: 2, Status: models.MatchFinished, HomeScore: 1},
+		{ID: 2, HomeTeamID: 2, AwayTeamID: 1, Status: models.MatchFinished, HomeScore: 1},
+		{ID: 3, HomeTeamID: 1, AwayTeamID: 2, Status: models.MatchFinished},
+	}
+	lineups := []lineupEntry{
+		{MatchID: 1, TeamID: 1, PlayerID: 1, Starter: true},
+		{MatchID: 1, TeamID: 2, PlayerID: 2, Starter: true},
+		{MatchID: 2, TeamID: 1, PlayerID: 1, Starter: true},
+		{MatchID: 3, TeamID: 1, PlayerID: 1, Starter: true},
+		{MatchID: 3, TeamID: 1, PlayerID: 3},
+		{MatchID: 3, TeamID: 1, PlayerID: 5, Starter: true},
+	}
+	events := []models.MatchEvent{
+		{MatchID: 3, TeamID: 1, PlayerID: 3, RelatedPlayerID: int64Ptr(1), Type: models.EventSubstitution, Minute: 50},
+	}
+	goalkeepers := map[int64]bool{1: true, 2: true, 3: true}
+
+	assert.Equal(t, []models.LeaderboardEntry{
+		{Rank: 1, PlayerID: 1, TeamID: 1, Value: 1, Appearances: 3, Minutes: 230},
+	}, rankLeaderboard(models.LeaderboardCleanSheets, events, lineups, matches, goalkeepers))
+}
+
+func TestPageBounds(t *testing.T) {
+	maxInt := int(^uint(0) >> 1)
+	tests := []struct {
+		total, page, perPage int
+		from, to             int
+	}{
+		{45, 1, 20, 0, 20},
+		{45, 3, 20, 40, 45},
+		{45, 4, 20, 45, 45},
+		{0, 1, 20, 0, 0},
+		{45, maxInt/20 + 2, 20, 45, 45},
+		{45, maxInt, 100, 45, 45},
+	}
+	for _, tt := range tests {
+		from, to := pageBounds(tt.total, models.Pagination{Page: tt.page, PerPage: tt.perPage})
+		assert.Equal(t, tt.from, from, "page %d of %d", tt.page, tt.total)
+		assert.Equal(t, tt.to, to, "page %d of %d", tt.page, tt.total)
+	}
+}
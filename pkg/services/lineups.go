@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// formationPattern matches formations such as 4-4-2 or 4-2-3-1.
+var formationPattern = regexp.MustCompile(`^[1-9](-[1-9]){1,4}$`)
+
+// LineupsService service interface.
+type LineupsService interface {
+	GetLineup(ctx context.Context, matchID, teamID int64) (models.Lineup, error)
+	SaveLineup(ctx context.Context, lineup models.Lineup) (models.Lineup, error)
+}
+
+type lineupsService struct {
+	db          *sqlx.DB
+	players     PlayersService
+	suspensions SuspensionsService
+}
+
+// NewLineupsService returns an initialized LineupsService implementation.
+func NewLineupsService(db *sqlx.DB, players PlayersService, suspensions SuspensionsService) LineupsService {
+	return &lineupsService{db: db, players: players, suspensions: suspensions}
+}
+
+// lineupEntry is a player listed in a lineup.
+type lineupEntry struct {
+	MatchID  int64 `db:"match_id"`
+	TeamID   int64 `db:"team_id"`
+	PlayerID int64 `db:"player_id"`
+	Starter  bool  `db:"starter"`
+}
+
+func (s *lineupsService) GetLineup(ctx context.Context, matchID, teamID int64) (models.Lineup, error) {
+	lineup := models.Lineup{MatchID: matchID, TeamID: teamID, Starters: []int64{}, Bench: []int64{}}
+
+	query := `SELECT formation FROM lineups WHERE match_id = $1 AND team_id = $2`
+	if err := s.db.GetContext(ctx, &lineup.Formation, query, matchID, teamID); err != nil {
+		return models.Lineup{}, fmt.Errorf("get a lineup: %s", err)
+	}
+
+	playersQuery := `
+		SELECT
+			match_id
+			, team_id
+			, player_id
+			, starter
+		FROM lineup_players
+		WHERE match_id = $1 AND team_id = $2
+		ORDER BY sort_order`
+
+	var entries []lineupEntry
+	if err := s.db.SelectContext(ctx, &entries, playersQuery, matchID, teamID); err != nil {
+		return models.Lineup{}, fmt.Errorf("get the list of lineup players: %s", err)
+	}
+	for _, entry := range entries {
+		if entry.Starter {
+			lineup.Starters = append(lineup.Starters, entry.PlayerID)
+		} else {
+			lineup.Bench = append(lineup.Bench, entry.PlayerID)
+		}
+	}
+
+	return lineup, nil
+}
+
+func (s *lineupsService) SaveLineup(ctx context.Context, lineup models.Lineup) (models.Lineup, error) {
+	squad, err := s.players.ListPlayersByTeams(ctx, lineup.TeamID, models.PlayerFilter{})
+	if err != nil {
+		return models.Lineup{}, fmt.Errorf("get the team players: %s", err)
+	}
+	suspended, err := s.suspensions.ListSuspendedPlayers(ctx, lineup.MatchID, lineup.TeamID)
+	if err != nil {
+		return models.Lineup{}, fmt.Errorf("get the suspended players: %s", err)
+	}
+	if err := validateLineup(lineup, squad, suspended); err != nil {
+		return models.Lineup{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Lineup{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var match models.Match
+	matchQuery := `SELECT id, home_team_id, away_team_id FROM matches WHERE id = $1 FOR UPDATE`
+	if err := tx.GetContext(ctx, &match, matchQuery, lineup.MatchID); err != nil {
+		return models.Lineup{}, fmt.Errorf("get the lineup match: %s", err)
+	}
+	if lineup.TeamID != match.HomeTeamID && lineup.TeamID != match.AwayTeamID {
+		return models.Lineup{}, NewValidationError(fmt.Sprintf("team %d does not play match %d", lineup.TeamID, lineup.MatchID))
+	}
+
+	query := `
+		INSERT INTO lineups (match_id, team_id, formation)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (match_id, team_id) DO UPDATE SET formation = EXCLUDED.formation, updated_at = CURRENT_TIMESTAMP`
+
+	if _, err := tx.ExecContext(ctx, query, lineup.MatchID, lineup.TeamID, lineup.Formation); err != nil {
+		return models.Lineup{}, fmt.Errorf("save lineup: %s", err)
+	}
+
+	deleteQuery := `DELETE FROM lineup_players WHERE match_id = $1 AND team_id = $2`
+	if _, err := tx.ExecContext(ctx, deleteQuery, lineup.MatchID, lineup.TeamID); err != nil {
+		return models.Lineup{}, fmt.Errorf("clear lineup players: %s", err)
+	}
+
+	insertQuery := `
+		INSERT INTO lineup_players (match_id, team_id, player_id, starter, sort_order)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	playerIDs := append(append([]int64{}, lineup.Starters...), lineup.Bench...)
+	for i, playerID := range playerIDs {
+		starter := i < len(lineup.Starters)
+		if _, err := tx.ExecContext(ctx, insertQuery, lineup.MatchID, lineup.TeamID, playerID, starter, i); err != nil {
+			return models.Lineup{}, fmt.Errorf("insert lineup player: %s", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Lineup{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	savedLineup, err := s.GetLineup(ctx, lineup.MatchID, lineup.TeamID)
+	if err != nil {
+		return models.Lineup{}, fmt.Errorf("get lineup: %s", err)
+	}
+
+	return savedLineup, nil
+}
+
+// validateLineup checks a lineup against the formation rules, the players
+// of the team and those suspended for the match, returning every problem
+// found.
+func validateLineup(lineup models.Lineup, squad []models.Player, suspended []int64) error {
+	var problems []string
+
+	if !formationPattern.MatchString(lineup.Formation) {
+		problems = append(problems, fmt.Sprintf("invalid formation %q", lineup.Formation))
+	} else {
+		outfield := 0
+		for _, line := range strings.Split(lineup.Formation, "-") {
+			n, _ := strconv.Atoi(line)
+			outfield += n
+		}
+		if outfield != models.StartersCount-1 {
+			problems = append(problems, fmt.Sprintf("formation %s must have %d outfield players", lineup.Formation, models.StartersCount-1))
+		}
+	}
+
+	if len(lineup.Starters) != models.StartersCount {
+		problems = append(problems, fmt.Sprintf("lineup must have %d starters, got %d", models.StartersCount, len(lineup.Starters)))
+	}
+
+	players := make(map[int64]models.Player, len(squad))
+	for _, player := range squad {
+		players[player.ID] = player
+	}
+
+	isSuspended := make(map[int64]bool, len(suspended))
+	for _, playerID := range suspended {
+		isSuspended[playerID] = true
+	}
+
+	listed := make(map[int64]bool)
+	for _, playerID := range append(append([]int64{}, lineup.Starters...), lineup.Bench...) {
+		if listed[playerID] {
+			problems = append(problems, fmt.Sprintf("player %d is listed more than once", playerID))
+			continue
+		}
+		listed[playerID] = true
+
+		if _, ok := players[playerID]; !ok {
+			problems = append(problems, fmt.Sprintf("player %d does not play for team %d", playerID, lineup.TeamID))
+		}
+		if isSuspended[playerID] {
+			problems = append(problems, fmt.Sprintf("player %d is suspended for match %d", playerID, lineup.MatchID))
+		}
+	}
+
+	goalkeepers := 0
+	for _, playerID := range lineup.Starters {
+		if players[playerID].Position == models.PositionGoalkeeper {
+			goalkeepers++
+		}
+	}
+	if goalkeepers != 1 {
+		problems = append(problems, fmt.Sprintf("lineup must start exactly one goalkeeper, got %d", goalkeepers))
+	}
+
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+	return nil
+}
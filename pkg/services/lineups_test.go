@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestValidateLineup(t *testing.T) {
+	squad := []models.Player{{ID: 1, Position: models.PositionGoalkeeper}, {ID: 2, Position: models.PositionGoalkeeper}}
+	for id := int64(3); id <= 14; id++ {
+		squad = append(squad, models.Player{ID: id, Position: models.PositionMidfielder})
+	}
+
+	valid := models.Lineup{
+		TeamID:    1,
+		Formation: "4-3-3",
+		Starters:  []int64{1, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		Bench:     []int64{2, 13, 14},
+	}
+	assert.NoError(t, validateLineup(valid, squad, nil))
+
+	invalid := models.Lineup{
+		MatchID:   5,
+		TeamID:    1,
+		Formation: "4-4-3",
+		Starters:  []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Bench:     []int64{10, 99},
+	}
+	assert.Equal(t, NewValidationError(
+		"formation 4-4-3 must have 10 outfield players",
+		"lineup must have 11 starters, got 10",
+		"player 5 is suspended for match 5",
+		"player 10 is listed more than once",
+		"player 99 does not play for team 1",
+		"lineup must start exactly one goalkeeper, got 2",
+	), validateLineup(invalid, squad, []int64{5}))
+
+	assert.Equal(t, NewValidationError(`invalid formation "433"`),
+		validateLineup(models.Lineup{TeamID: 1, Formation: "433", Starters: valid.Starters}, squad, nil))
+}
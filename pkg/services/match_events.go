@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// MatchEventsService service interface.
+type MatchEventsService interface {
+	ListMatchEvents(ctx context.Context, matchID int64) ([]models.MatchEvent, error)
+	CreateMatchEvent(ctx context.Context, event models.MatchEvent) (models.MatchEvent, error)
+	DeleteMatchEvent(ctx context.Context, matchID, id int64) error
+}
+
+type matchEventsService struct {
+	db *sqlx.DB
+}
+
+// NewMatchEventsService returns an initialized MatchEventsService implementation.
+func NewMatchEventsService(db *sqlx.DB) MatchEventsService {
+	return &matchEventsService{db: db}
+}
+
+func (s *matchEventsService) ListMatchEvents(ctx context.Context, matchID int64) ([]models.MatchEvent, error) {
+	query := `
+		SELECT
+			id
+			, match_id
+			, team_id
+			, player_id
+			, related_player_id
+			, type
+			, minute
+			, added_time
+			, created_at
+			, updated_at
+		FROM match_events
+		WHERE match_id = $1
+		ORDER BY minute, added_time, id`
+
+	var events []models.MatchEvent
+	if err := s.db.SelectContext(ctx, &events, query, matchID); err != nil {
+		return nil, fmt.Errorf("get the list of match events: %s", err)
+	}
+
+	return events, nil
+}
+
+func (s *matchEventsService) getMatchEvent(ctx context.Context, id int64) (models.MatchEvent, error) {
+	query := `
+		SELECT
+			id
+			, match_id
+			, team_id
+			, player_id
+			, related_player_id
+			, type
+			, minute
+			, added_time
+			, created_at
+			, updated_at
+		FROM match_events
+		WHERE id = $1`
+
+	var event models.MatchEvent
+	if err := s.db.GetContext(ctx, &event, query, id); err != nil {
+		return models.MatchEvent{}, fmt.Errorf("get a match event: %s", err)
+	}
+
+	return event, nil
+}
+
+func (s *matchEventsService) CreateMatchEvent(ctx context.Context, event models.MatchEvent) (models.MatchEvent, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.MatchEvent{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var match models.Match
+	matchQuery := `SELECT id, home_team_id, away_team_id, kickoff FROM matches WHERE id = $1 FOR UPDATE`
+	if err := tx.GetContext(ctx, &match, matchQuery, event.MatchID); err != nil {
+		return models.MatchEvent{}, fmt.Errorf("get the event match: %s", err)
+	}
+	if event.TeamID != match.HomeTeamID && event.TeamID != match.AwayTeamID {
+		return models.MatchEvent{}, NewValidationError(fmt.Sprintf("team %d does not play match %d", event.TeamID, event.MatchID))
+	}
+
+	playerIDs := []int64{event.PlayerID}
+	if event.RelatedPlayerID != nil {
+		playerIDs = append(playerIDs, *event.RelatedPlayerID)
+	}
+	// Players are checked against the team they belonged to at kickoff, so
+	// that the events of past matches survive their transfers.
+	membershipQuery := `
+		SELECT EXISTS (
+			SELECT 1 FROM player_memberships
+			WHERE player_id = $1 AND team_id = $2
+				AND from_date <= $3::DATE AND (to_date IS NULL OR $3::DATE < to_date)
+		)`
+	for _, playerID := range playerIDs {
+		var member bool
+		if err := tx.GetContext(ctx, &member, membershipQuery, playerID, event.TeamID, match.Kickoff); err != nil {
+			return models.MatchEvent{}, fmt.Errorf("get the event player membership: %s", err)
+		}
+		if !member {
+			return models.MatchEvent{}, NewValidationError(fmt.Sprintf("player %d did not play for team %d at the kickoff of match %d",
+				playerID, event.TeamID, event.MatchID))
+		}
+	}
+
+	query := `
+		INSERT INTO match_events (match_id, team_id, player_id, related_player_id, type, minute, added_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`
+
+	var id int64
+	if err := tx.QueryRowxContext(ctx, query, event.MatchID, event.TeamID, event.PlayerID, event.RelatedPlayerID,
+		event.Type, event.Minute, event.AddedTime).Scan(&id); err != nil {
+		return models.MatchEvent{}, fmt.Errorf("insert new match event: %s", err)
+	}
+
+	if err := updateMatchScore(ctx, tx, event.MatchID); err != nil {
+		return models.MatchEvent{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.MatchEvent{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	newEvent, err := s.getMatchEvent(ctx, id)
+	if err != nil {
+		return models.MatchEvent{}, fmt.Errorf("get new match event: %s", err)
+	}
+
+	return newEvent, nil
+}
+
+func (s *matchEventsService) DeleteMatchEvent(ctx context.Context, matchID, id int64) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM match_events WHERE match_id = $1 AND id = $2`
+	if _, err := tx.ExecContext(ctx, query, matchID, id); err != nil {
+		return fmt.Errorf("delete a match event: %s", err)
+	}
+
+	if err := updateMatchScore(ctx, tx, matchID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %s", err)
+	}
+
+	return nil
+}
+
+// updateMatchScore recomputes the score of a match from its goal events,
+// so that the two can never disagree, and moves the winner of a finished
+// bracket tie on again when the corrected score changes it.
+func updateMatchScore(ctx context.Context, tx *sqlx.Tx, matchID int64) error {
+	query := `
+		UPDATE matches m SET
+			home_score = (
+				SELECT COUNT(*) FROM match_events e
+				WHERE e.match_id = m.id
+					AND ((e.type = $2 AND e.team_id = m.home_team_id) OR (e.type = $3 AND e.team_id = m.away_team_id))
+			)
+			, away_score = (
+				SELECT COUNT(*) FROM match_events e
+				WHERE e.match_id = m.id
+					AND ((e.type = $2 AND e.team_id = m.away_team_id) OR (e.type = $3 AND e.team_id = m.home_team_id))
+			)
+			, updated_at = CURRENT_TIMESTAMP
+		WHERE m.id = $1
+		RETURNING m.status`
+
+	var status string
+	if err := tx.GetContext(ctx, &status, query, matchID, models.EventGoal, models.EventOwnGoal); err != nil {
+		return fmt.Errorf("update match score: %s", err)
+	}
+
+	if status == models.MatchFinished {
+		return advanceBracket(ctx, tx, matchID)
+	}
+
+	return nil
+}
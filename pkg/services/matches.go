@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// MatchesService service interface.
+type MatchesService interface {
+	ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error)
+	GetMatch(ctx context.Context, id int64) (models.Match, error)
+	CreateMatch(ctx context.Context, match models.Match, force bool) (models.Match, error)
+	DeleteMatch(ctx context.Context, id int64) error
+	UpdateMatch(ctx context.Context, match models.Match, force bool) (models.Match, error)
+	ValidateMatch(ctx context.Context, match models.Match) ([]models.Conflict, error)
+	ListTeamCalendar(ctx context.Context, teamID int64) ([]models.CalendarMatch, error)
+}
+
+type matchesService struct {
+	db    *sqlx.DB
+	rules SchedulingRules
+}
+
+// NewMatchesService returns an initialized MatchesService implementation.
+// Creating or rescheduling a match that breaks the scheduling rules fails
+// with a ScheduleConflictError unless forced.
+func NewMatchesService(db *sqlx.DB, rules SchedulingRules) MatchesService {
+	return &matchesService{db: db, rules: rules}
+}
+
+func (s *matchesService) ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error) {
+	var conds conditions
+	if filter.CompetitionID != nil {
+		conds.add("competition_id = ?", *filter.CompetitionID)
+	}
+	if filter.SeasonID != nil {
+		conds.add("season_id = ?", *filter.SeasonID)
+	}
+	if filter.TeamID != nil {
+		conds.add("(home_team_id = ? OR away_team_id = ?)", *filter.TeamID, *filter.TeamID)
+	}
+	if filter.VenueID != nil {
+		conds.add("venue_id = ?", *filter.VenueID)
+	}
+	if filter.Status != "" {
+		conds.add("status = ?", filter.Status)
+	}
+
+	query := `
+		SELECT
+			id
+			, competition_id
+			, season_id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+			, home_score
+			, away_score
+			, home_penalties
+			, away_penalties
+			, (
+				SELECT n.match_id
+				FROM bracket_nodes b
+				JOIN bracket_nodes n ON n.season_id IS NOT DISTINCT FROM b.season_id
+					AND n.competition_id = b.competition_id AND n.round = b.round + 1 AND n.position = b.position / 2
+				WHERE b.match_id = matches.id
+			) AS next_match_id
+			, created_at
+			, updated_at
+		FROM matches` + conds.where() + `
+		ORDER BY kickoff`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, s.db.Rebind(query), conds.args...); err != nil {
+		return nil, fmt.Errorf("get the list of matches: %s", err)
+	}
+
+	return matches, nil
+}
+
+func (s *matchesService) GetMatch(ctx context.Context, id int64) (models.Match, error) {
+	query := `
+		SELECT
+			id
+			, competition_id
+			, season_id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+			, home_score
+			, away_score
+			, home_penalties
+			, away_penalties
+			, (
+				SELECT n.match_id
+				FROM bracket_nodes b
+				JOIN bracket_nodes n ON n.season_id IS NOT DISTINCT FROM b.season_id
+					AND n.competition_id = b.competition_id AND n.round = b.round + 1 AND n.position = b.position / 2
+				WHERE b.match_id = matches.id
+			) AS next_match_id
+			, created_at
+			, updated_at
+		FROM matches
+		WHERE id = $1`
+
+	var match models.Match
+	if err := s.db.GetContext(ctx, &match, query, id); err != nil {
+		return models.Match{}, fmt.Errorf("get a match: %s", err)
+	}
+
+	return match, nil
+}
+
+func (s *matchesService) CreateMatch(ctx context.Context, match models.Match, force bool) (models.Match, error) {
+	if match.Status == "" {
+		match.Status = models.MatchScheduled
+	}
+	if err := validatePenalties(match); err != nil {
+		return models.Match{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	if match, err = seasonCompetition(ctx, tx, match); err != nil {
+		return models.Match{}, err
+	}
+
+	if err := lockMatchSchedule(ctx, tx, match); err != nil {
+		return models.Match{}, err
+	}
+	conflicts, err := scheduleConflicts(ctx, tx, match, s.rules)
+	if err != nil {
+		return models.Match{}, err
+	}
+	if len(conflicts) > 0 && !force {
+		return models.Match{}, &ScheduleConflictError{Conflicts: conflicts}
+	}
+
+	query := `
+		INSERT INTO matches (competition_id, season_id, home_team_id, away_team_id, kickoff, venue_id, status,
+			home_penalties, away_penalties)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id`
+
+	var id int64
+	if err := tx.QueryRowxContext(ctx, query, match.CompetitionID, match.SeasonID, match.HomeTeamID, match.AwayTeamID, match.Kickoff,
+		match.VenueID, match.Status, match.HomePenalties, match.AwayPenalties).Scan(&id); err != nil {
+		return models.Match{}, fmt.Errorf("insert new match: %s", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Match{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	newMatch, err := s.GetMatch(ctx, id)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("get new match: %s", err)
+	}
+	newMatch.Conflicts = conflicts
+
+	return newMatch, nil
+}
+
+func (s *matchesService) DeleteMatch(ctx context.Context, id int64) error {
+	query := `DELETE FROM matches WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("delete a match: %s", err)
+	}
+
+	return nil
+}
+
+func (s *matchesService) UpdateMatch(ctx context.Context, match models.Match, force bool) (models.Match, error) {
+	if match.Status == "" {
+		match.Status = models.MatchScheduled
+	}
+	if err := validatePenalties(match); err != nil {
+		return models.Match{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	currentQuery := `
+		SELECT
+			id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+		FROM matches
+		WHERE id = $1
+		FOR UPDATE`
+
+	var current models.Match
+	if err := tx.GetContext(ctx, &current, currentQuery, match.ID); err != nil {
+		return models.Match{}, fmt.Errorf("get match: %s", err)
+	}
+
+	if match, err = seasonCompetition(ctx, tx, match); err != nil {
+		return models.Match{}, err
+	}
+
+	// Only rescheduling is checked, so that a match forced through can
+	// still be played and finished.
+	var conflicts []models.Conflict
+	if rescheduled(current, match) {
+		if err := lockMatchSchedule(ctx, tx, match); err != nil {
+			return models.Match{}, err
+		}
+		conflicts, err = scheduleConflicts(ctx, tx, match, s.rules)
+		if err != nil {
+			return models.Match{}, err
+		}
+		if len(conflicts) > 0 && !force {
+			return models.Match{}, &ScheduleConflictError{Conflicts: conflicts}
+		}
+	}
+
+	query := `
+		UPDATE matches SET
+			competition_id=$1
+			, season_id=$2
+			, home_team_id=$3
+			, away_team_id=$4
+			, kickoff=$5
+			, venue_id=$6
+			, status=$7
+			, home_penalties=$10
+			, away_penalties=$11
+			, sequence=CASE
+				WHEN kickoff <> $5 OR venue_id IS DISTINCT FROM $6 OR (status = $9) <> ($7 = $9) THEN sequence + 1
+				ELSE sequence
+			END
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$8`
+
+	if _, err := tx.ExecContext(ctx, query, match.CompetitionID, match.SeasonID, match.HomeTeamID, match.AwayTeamID, match.Kickoff,
+		match.VenueID, match.Status, match.ID, models.MatchCancelled, match.HomePenalties, match.AwayPenalties); err != nil {
+		return models.Match{}, fmt.Errorf("update match: %s", err)
+	}
+
+	if match.Status == models.MatchFinished {
+		if err := advanceBracket(ctx, tx, match.ID); err != nil {
+			return models.Match{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Match{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	updatedMatch, err := s.GetMatch(ctx, match.ID)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("get match: %s", err)
+	}
+	updatedMatch.Conflicts = conflicts
+
+	return updatedMatch, nil
+}
+
+func (s *matchesService) ValidateMatch(ctx context.Context, match models.Match) ([]models.Conflict, error) {
+	if match.Status == "" {
+		match.Status = models.MatchScheduled
+	}
+
+	return scheduleConflicts(ctx, s.db, match, s.rules)
+}
+
+func (s *matchesService) ListTeamCalendar(ctx context.Context, teamID int64) ([]models.CalendarMatch, error) {
+	query := `
+		SELECT
+			m.id
+			, c.name AS competition_name
+			, h.name AS home_team_name
+			, a.name AS away_team_name
+			, m.kickoff
+			, v.name AS venue_name
+			, v.city AS venue_city
+			, m.status
+			, m.home_score
+			, m.away_score
+			, m.sequence
+			, COALESCE(m.updated_at, m.created_at) AS updated_at
+		FROM matches m
+		JOIN teams h ON h.id = m.home_team_id
+		JOIN teams a ON a.id = m.away_team_id
+		LEFT JOIN competitions c ON c.id = m.competition_id
+		LEFT JOIN venues v ON v.id = m.venue_id
+		WHERE m.home_team_id = $1 OR m.away_team_id = $1
+		ORDER BY m.kickoff, m.id`
+
+	var matches []models.CalendarMatch
+	if err := s.db.SelectContext(ctx, &matches, query, teamID); err != nil {
+		return nil, fmt.Errorf("get the team calendar: %s", err)
+	}
+
+	return matches, nil
+}
+
+// seasonCompetition files a match of a season under the competition of the
+// season, which it cannot claim to be part of another competition.
+func seasonCompetition(ctx context.Context, tx *sqlx.Tx, match models.Match) (models.Match, error) {
+	if match.SeasonID == nil {
+		return match, nil
+	}
+
+	var competitionID int64
+	query := `SELECT competition_id FROM seasons WHERE id = $1 FOR SHARE`
+	err := tx.GetContext(ctx, &competitionID, query, *match.SeasonID)
+	if err == sql.ErrNoRows {
+		return models.Match{}, NewValidationError(fmt.Sprintf("season %d does not exist", *match.SeasonID))
+	}
+	if err != nil {
+		return models.Match{}, fmt.Errorf("get the match season: %s", err)
+	}
+
+	if match.CompetitionID != nil && *match.CompetitionID != competitionID {
+		return models.Match{}, NewValidationError(fmt.Sprintf("season %d is not a season of competition %d",
+			*match.SeasonID, *match.CompetitionID))
+	}
+	match.CompetitionID = &competitionID
+
+	return match, nil
+}
+
+// validatePenalties checks a penalty shoot-out score is complete and has a
+// winner.
+func validatePenalties(match models.Match) error {
+	if match.HomePenalties == nil && match.AwayPenalties == nil {
+		return nil
+	}
+	if match.HomePenalties == nil || match.AwayPenalties == nil {
+		return NewValidationError("home_penalties and away_penalties must be set together")
+	}
+
+	var problems []string
+	if *match.HomePenalties < 0 || *match.AwayPenalties < 0 {
+		problems = append(problems, "penalties must not be negative")
+	}
+	if *match.HomePenalties == *match.AwayPenalties {
+		problems = append(problems, "a penalty shoot-out cannot finish level")
+	}
+
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+	return nil
+}
@@ -70,13 +70,59 @@ func (_m *PlayersService) GetPlayer(ctx context.Context, id int64) (models.Playe
 	return r0, r1
 }
 
-// ListPlayers provides a mock function with given fields: ctx
-func (_m *PlayersService) ListPlayers(ctx context.Context) ([]models.Player, error) {
-	ret := _m.Called(ctx)
+// ListAvailableJerseyNumbers provides a mock function with given fields: ctx, team
+func (_m *PlayersService) ListAvailableJerseyNumbers(ctx context.Context, team int64) ([]int, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPlayerMemberships provides a mock function with given fields: ctx, playerID
+func (_m *PlayersService) ListPlayerMemberships(ctx context.Context, playerID int64) ([]models.PlayerMembership, error) {
+	ret := _m.Called(ctx, playerID)
+
+	var r0 []models.PlayerMembership
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.PlayerMembership); ok {
+		r0 = rf(ctx, playerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PlayerMembership)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPlayers provides a mock function with given fields: ctx, filter
+func (_m *PlayersService) ListPlayers(ctx context.Context, filter models.PlayerFilter) ([]models.Player, error) {
+	ret := _m.Called(ctx, filter)
 
 	var r0 []models.Player
-	if rf, ok := ret.Get(0).(func(context.Context) []models.Player); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, models.PlayerFilter) []models.Player); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Player)
@@ -84,8 +130,8 @@ func (_m *PlayersService) ListPlayers(ctx context.Context) ([]models.Player, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, models.PlayerFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -93,13 +139,13 @@ func (_m *PlayersService) ListPlayers(ctx context.Context) ([]models.Player, err
 	return r0, r1
 }
 
-// ListPlayersByTeams provides a mock function with given fields: ctx, team
-func (_m *PlayersService) ListPlayersByTeams(ctx context.Context, team int64) ([]models.Player, error) {
-	ret := _m.Called(ctx, team)
+// ListPlayersByTeams provides a mock function with given fields: ctx, team, filter
+func (_m *PlayersService) ListPlayersByTeams(ctx context.Context, team int64, filter models.PlayerFilter) ([]models.Player, error) {
+	ret := _m.Called(ctx, team, filter)
 
 	var r0 []models.Player
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Player); ok {
-		r0 = rf(ctx, team)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.PlayerFilter) []models.Player); ok {
+		r0 = rf(ctx, team, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Player)
@@ -107,8 +153,29 @@ func (_m *PlayersService) ListPlayersByTeams(ctx context.Context, team int64) ([
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, team)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.PlayerFilter) error); ok {
+		r1 = rf(ctx, team, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferPlayer provides a mock function with given fields: ctx, transfer
+func (_m *PlayersService) TransferPlayer(ctx context.Context, transfer models.Transfer) (models.PlayerMembership, error) {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 models.PlayerMembership
+	if rf, ok := ret.Get(0).(func(context.Context, models.Transfer) models.PlayerMembership); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Get(0).(models.PlayerMembership)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.Transfer) error); ok {
+		r1 = rf(ctx, transfer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2,98 +2,195 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/jmoiron/sqlx"
 
 	"soccer/pkg/models"
 )
 
+// Jersey numbers a player can wear.
+const (
+	minJerseyNumber = 1
+	maxJerseyNumber = 99
+)
+
+// jerseyNumberConstraint guarantees that team mates wear different numbers.
+const jerseyNumberConstraint = "players_team_id_jersey_number_key"
+
+// playerColumns lists the players columns, the age being computed from
+// the date of birth and the availability from the injuries running today;
+// suspensions are added by markSuspended.
+const playerColumns = `
+	id
+	, name
+	, team_id
+	, jersey_number
+	, position
+	, role
+	, date_of_birth
+	, EXTRACT(YEAR FROM AGE(date_of_birth))::INT AS age
+	, nationality
+	, height_cm
+	, weight_kg
+	, preferred_foot
+	, CASE WHEN EXISTS (
+		SELECT 1 FROM injuries i
+		WHERE i.player_id = players.id
+			AND i.start_date <= CURRENT_DATE
+			AND (i.actual_return IS NULL OR i.actual_return > CURRENT_DATE)
+	) THEN 'injured' ELSE 'fit' END AS availability
+	, created_at
+	, updated_at`
+
 // PlayersService service interface.
 type PlayersService interface {
-	ListPlayers(ctx context.Context) ([]models.Player, error)
-	ListPlayersByTeams(ctx context.Context, team int64) ([]models.Player, error)
+	ListPlayers(ctx context.Context, filter models.PlayerFilter) ([]models.Player, error)
+	ListPlayersByTeams(ctx context.Context, team int64, filter models.PlayerFilter) ([]models.Player, error)
 	GetPlayer(ctx context.Context, id int64) (models.Player, error)
 	CreatePlayer(ctx context.Context, player models.Player) (models.Player, error)
 	DeletePlayer(ctx context.Context, id int64) error
 	UpdatePlayer(ctx context.Context, player models.Player) (models.Player, error)
+	TransferPlayer(ctx context.Context, transfer models.Transfer) (models.PlayerMembership, error)
+	ListPlayerMemberships(ctx context.Context, playerID int64) ([]models.PlayerMembership, error)
+	ListAvailableJerseyNumbers(ctx context.Context, team int64) ([]int, error)
 }
 
 type playersService struct {
-	db *sqlx.DB
+	db          *sqlx.DB
+	suspensions SuspensionsService
 }
 
 // NewPlayersService returns an initialized PlayersService implementation.
-func NewPlayersService(db *sqlx.DB) PlayersService {
-	return &playersService{db: db}
+func NewPlayersService(db *sqlx.DB, suspensions SuspensionsService) PlayersService {
+	return &playersService{db: db, suspensions: suspensions}
 }
 
-func (s *playersService) ListPlayers(ctx context.Context) ([]models.Player, error) {
-	query := `
-		SELECT
-			id
-			, name
-			, team_id
-			, jersey_number
-			, created_at
-			, updated_at
-		FROM players`
+func (s *playersService) ListPlayers(ctx context.Context, filter models.PlayerFilter) ([]models.Player, error) {
+	conds := playerConditions(filter)
+	query := `SELECT ` + playerColumns + ` FROM players` + conds.where()
 
 	var players []models.Player
-	if err := s.db.SelectContext(ctx, &players, query); err != nil {
+	if err := s.db.SelectContext(ctx, &players, s.db.Rebind(query), conds.args...); err != nil {
 		return nil, fmt.Errorf("get the list of players: %s", err)
 	}
+	if err := s.markSuspended(ctx, players); err != nil {
+		return nil, err
+	}
 
 	return players, nil
 }
 
-func (s *playersService) ListPlayersByTeams(ctx context.Context, team int64) ([]models.Player, error) {
-	query := `
-		SELECT
-			id
-			, name
-			, team_id
-			, jersey_number
-			, created_at
-			, updated_at
-		FROM players WHERE team_id=$1`
+func (s *playersService) ListPlayersByTeams(ctx context.Context, team int64, filter models.PlayerFilter) ([]models.Player, error) {
+	conds := playerConditions(filter)
+	conds.add("team_id = ?", team)
+	query := `SELECT ` + playerColumns + ` FROM players` + conds.where()
 
 	var players []models.Player
-	if err := s.db.SelectContext(ctx, &players, query, team); err != nil {
+	if err := s.db.SelectContext(ctx, &players, s.db.Rebind(query), conds.args...); err != nil {
 		return nil, fmt.Errorf("get the list players in team: %s", err)
 	}
+	if err := s.markSuspended(ctx, players); err != nil {
+		return nil, err
+	}
 
 	return players, nil
 }
 
 func (s *playersService) GetPlayer(ctx context.Context, id int64) (models.Player, error) {
-	query := `
-		SELECT
-			id
-			, name
-			, team_id
-			, jersey_number
-			, created_at
-			, updated_at
-		FROM players
-		WHERE id = $1`
+	query := `SELECT ` + playerColumns + ` FROM players WHERE id = $1`
 
 	var player models.Player
 	if err := s.db.GetContext(ctx, &player, query, id); err != nil {
 		return models.Player{}, fmt.Errorf("get an player: %s", err)
 	}
 
-	return player, nil
+	players := []models.Player{player}
+	if err := s.markSuspended(ctx, players); err != nil {
+		return models.Player{}, err
+	}
+
+	return players[0], nil
+}
+
+// markSuspended reports as suspended the fit players banned from the next
+// scheduled match of their team, as team availability does.
+func (s *playersService) markSuspended(ctx context.Context, players []models.Player) error {
+	fit := make(map[int64][]int)
+	for i, player := range players {
+		if player.Availability == models.AvailabilityFit {
+			fit[player.TeamID] = append(fit[player.TeamID], i)
+		}
+	}
+
+	for teamID, indices := range fit {
+		matchID, err := nextTeamMatch(ctx, s.db, teamID, time.Now())
+		if err != nil {
+			return err
+		}
+		if matchID == nil {
+			continue
+		}
+
+		suspended, err := s.suspensions.ListSuspendedPlayers(ctx, *matchID, teamID)
+		if err != nil {
+			return fmt.Errorf("get the suspended players: %s", err)
+		}
+		isSuspended := make(map[int64]bool, len(suspended))
+		for _, playerID := range suspended {
+			isSuspended[playerID] = true
+		}
+		for _, i := range indices {
+			if isSuspended[players[i].ID] {
+				players[i].Availability = models.AvailabilitySuspended
+			}
+		}
+	}
+
+	return nil
 }
 
 func (s *playersService) CreatePlayer(ctx context.Context, player models.Player) (models.Player, error) {
-	query := "INSERT INTO players (name, team_id ,jersey_number) VALUES ($1, $2 , $3) RETURNING id"
+	player, err := normalizePlayer(player)
+	if err != nil {
+		return models.Player{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Player{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO players (name, team_id, jersey_number, position, role, date_of_birth,
+			nationality, height_cm, weight_kg, preferred_foot)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id`
 
 	var id int64
-	if err := s.db.QueryRowxContext(ctx, query, player.Name, player.TeamID, player.JerseyNumber).Scan(&id); err != nil {
+	if err := tx.QueryRowxContext(ctx, query, player.Name, player.TeamID, player.JerseyNumber, player.Position,
+		player.Role, player.DateOfBirth, player.Nationality, player.HeightCm, player.WeightKg,
+		player.PreferredFoot).Scan(&id); err != nil {
+		if isUniqueViolation(err, jerseyNumberConstraint) {
+			return models.Player{}, jerseyNumberTaken(player.JerseyNumber, player.TeamID)
+		}
 		return models.Player{}, fmt.Errorf("insert new player: %s", err)
 	}
 
+	if _, err := openMembership(ctx, tx, id, player.TeamID, time.Now()); err != nil {
+		return models.Player{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Player{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
 	newPlayer, err := s.GetPlayer(ctx, id)
 	if err != nil {
 		return models.Player{}, fmt.Errorf("get new player: %s", err)
@@ -113,12 +210,57 @@ func (s *playersService) DeletePlayer(ctx context.Context, id int64) error {
 }
 
 func (s *playersService) UpdatePlayer(ctx context.Context, player models.Player) (models.Player, error) {
-	query := `UPDATE players SET name=$1, jersey_number=$2 , team_id=$3  Where id=$4`
+	player, err := normalizePlayer(player)
+	if err != nil {
+		return models.Player{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Player{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
 
-	if _, err := s.db.ExecContext(ctx, query, player.Name, player.JerseyNumber, player.TeamID, player.ID); err != nil {
+	var teamID int64
+	if err := tx.GetContext(ctx, &teamID, `SELECT team_id FROM players WHERE id = $1 FOR UPDATE`, player.ID); err != nil {
+		return models.Player{}, fmt.Errorf("get player team: %s", err)
+	}
+
+	query := `
+		UPDATE players SET
+			name=$1
+			, jersey_number=$2
+			, team_id=$3
+			, position=$4
+			, role=$5
+			, date_of_birth=$6
+			, nationality=$7
+			, height_cm=$8
+			, weight_kg=$9
+			, preferred_foot=$10
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$11`
+
+	if _, err := tx.ExecContext(ctx, query, player.Name, player.JerseyNumber, player.TeamID, player.Position,
+		player.Role, player.DateOfBirth, player.Nationality, player.HeightCm, player.WeightKg,
+		player.PreferredFoot, player.ID); err != nil {
+		if isUniqueViolation(err, jerseyNumberConstraint) {
+			return models.Player{}, jerseyNumberTaken(player.JerseyNumber, player.TeamID)
+		}
 		return models.Player{}, fmt.Errorf("Update player: %s", err)
 	}
 
+	// Changing the team through an update is a transfer effective today.
+	if teamID != player.TeamID {
+		if _, err := openMembership(ctx, tx, player.ID, player.TeamID, time.Now()); err != nil {
+			return models.Player{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Player{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
 	Player, err := s.GetPlayer(ctx, player.ID)
 	if err != nil {
 		return models.Player{}, fmt.Errorf("get player: %s", err)
@@ -126,3 +268,216 @@ func (s *playersService) UpdatePlayer(ctx context.Context, player models.Player)
 
 	return Player, nil
 }
+
+func (s *playersService) TransferPlayer(ctx context.Context, transfer models.Transfer) (models.PlayerMembership, error) {
+	if transfer.JerseyNumber != "" {
+		number, err := normalizeJerseyNumber(transfer.JerseyNumber)
+		if err != nil {
+			return models.PlayerMembership{}, err
+		}
+		transfer.JerseyNumber = number
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.PlayerMembership{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var teamID int64
+	if err := tx.GetContext(ctx, &teamID, `SELECT team_id FROM players WHERE id = $1 FOR UPDATE`, transfer.PlayerID); err != nil {
+		return models.PlayerMembership{}, fmt.Errorf("get player team: %s", err)
+	}
+	if teamID == transfer.TeamID {
+		return models.PlayerMembership{}, NewValidationError(fmt.Sprintf("player %d already plays for team %d", transfer.PlayerID, transfer.TeamID))
+	}
+
+	query := `
+		UPDATE players SET
+			team_id=$1
+			, jersey_number=COALESCE(NULLIF($2, ''), jersey_number)
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$3`
+
+	if _, err := tx.ExecContext(ctx, query, transfer.TeamID, transfer.JerseyNumber, transfer.PlayerID); err != nil {
+		if isUniqueViolation(err, jerseyNumberConstraint) {
+			return models.PlayerMembership{}, NewConflictError(fmt.Sprintf(
+				"the jersey number of player %d is already taken in team %d", transfer.PlayerID, transfer.TeamID))
+		}
+		return models.PlayerMembership{}, fmt.Errorf("update player team: %s", err)
+	}
+
+	id, err := openMembership(ctx, tx, transfer.PlayerID, transfer.TeamID, transfer.Date)
+	if err != nil {
+		return models.PlayerMembership{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.PlayerMembership{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	memberships, err := s.ListPlayerMemberships(ctx, transfer.PlayerID)
+	if err != nil {
+		return models.PlayerMembership{}, fmt.Errorf("get new membership: %s", err)
+	}
+	for _, membership := range memberships {
+		if membership.ID == id {
+			return membership, nil
+		}
+	}
+
+	return models.PlayerMembership{}, fmt.Errorf("get new membership: membership %d not found", id)
+}
+
+func (s *playersService) ListPlayerMemberships(ctx context.Context, playerID int64) ([]models.PlayerMembership, error) {
+	query := `
+		SELECT
+			pm.id
+			, pm.player_id
+			, pm.team_id
+			, t.name AS team_name
+			, pm.from_date
+			, pm.to_date
+		FROM player_memberships pm
+		JOIN teams t ON t.id = pm.team_id
+		WHERE pm.player_id = $1
+		ORDER BY pm.from_date, pm.id`
+
+	var memberships []models.PlayerMembership
+	if err := s.db.SelectContext(ctx, &memberships, query, playerID); err != nil {
+		return nil, fmt.Errorf("get the list of player memberships: %s", err)
+	}
+
+	return memberships, nil
+}
+
+func (s *playersService) ListAvailableJerseyNumbers(ctx context.Context, team int64) ([]int, error) {
+	query := `SELECT jersey_number FROM players WHERE team_id = $1 AND jersey_number IS NOT NULL`
+
+	var taken []string
+	if err := s.db.SelectContext(ctx, &taken, query, team); err != nil {
+		return nil, fmt.Errorf("get the list of taken jersey numbers: %s", err)
+	}
+
+	return availableJerseyNumbers(taken), nil
+}
+
+// availableJerseyNumbers returns the jersey numbers not in taken.
+func availableJerseyNumbers(taken []string) []int {
+	used := make(map[string]bool, len(taken))
+	for _, number := range taken {
+		used[number] = true
+	}
+
+	available := []int{}
+	for n := minJerseyNumber; n <= maxJerseyNumber; n++ {
+		if !used[strconv.Itoa(n)] {
+			available = append(available, n)
+		}
+	}
+
+	return available
+}
+
+// normalizePlayer fills the position of a player from their role,
+// upper-cases the nationality country code and normalizes the jersey number.
+func normalizePlayer(player models.Player) (models.Player, error) {
+	player.Nationality = strings.ToUpper(player.Nationality)
+	if player.Nationality != "" && !govalidator.IsISO3166Alpha2(player.Nationality) {
+		return models.Player{}, NewValidationError(fmt.Sprintf("nationality %s is not an ISO 3166-1 alpha-2 country code", player.Nationality))
+	}
+
+	number, err := normalizeJerseyNumber(player.JerseyNumber)
+	if err != nil {
+		return models.Player{}, err
+	}
+	player.JerseyNumber = number
+
+	if player.Role == "" {
+		return player, nil
+	}
+
+	position := models.RolePositions[player.Role]
+	if player.Position == "" {
+		player.Position = position
+	}
+	if player.Position != position {
+		return models.Player{}, NewValidationError(fmt.Sprintf("role %s is not a %s position", player.Role, player.Position))
+	}
+
+	return player, nil
+}
+
+// normalizeJerseyNumber checks a jersey number is a whole number between
+// minJerseyNumber and maxJerseyNumber, and writes it without leading zeros
+// so that "07" and "7" are the same number.
+func normalizeJerseyNumber(number string) (string, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(number))
+	if err != nil || n < minJerseyNumber || n > maxJerseyNumber {
+		return "", NewValidationError(fmt.Sprintf("jersey number must be a whole number between %d and %d",
+			minJerseyNumber, maxJerseyNumber))
+	}
+	return strconv.Itoa(n), nil
+}
+
+// playerConditions returns the SQL conditions matching the filter.
+func playerConditions(filter models.PlayerFilter) conditions {
+	var conds conditions
+	if filter.Position != "" {
+		conds.add("position = ?", filter.Position)
+	}
+	if filter.Role != "" {
+		conds.add("role = ?", filter.Role)
+	}
+	if filter.Nationality != "" {
+		conds.add("nationality = ?", strings.ToUpper(filter.Nationality))
+	}
+	if filter.PreferredFoot != "" {
+		conds.add("preferred_foot = ?", filter.PreferredFoot)
+	}
+	if filter.MinAge != nil {
+		conds.add("date_of_birth <= CURRENT_DATE - MAKE_INTERVAL(years => ?)", *filter.MinAge)
+	}
+	if filter.MaxAge != nil {
+		conds.add("date_of_birth > CURRENT_DATE - MAKE_INTERVAL(years => ?)", *filter.MaxAge+1)
+	}
+	if filter.MinHeightCm != nil {
+		conds.add("height_cm >= ?", *filter.MinHeightCm)
+	}
+	if filter.MaxHeightCm != nil {
+		conds.add("height_cm > 0 AND height_cm <= ?", *filter.MaxHeightCm)
+	}
+	return conds
+}
+
+// jerseyNumberTaken returns the conflict of two team mates sharing a number.
+func jerseyNumberTaken(number string, team int64) *ConflictError {
+	return NewConflictError(fmt.Sprintf("jersey number %s is already taken in team %d", number, team))
+}
+
+// openMembership closes the current membership of a player the day the
+// new one, at the given team, starts. It returns the new membership ID.
+func openMembership(ctx context.Context, tx *sqlx.Tx, playerID, teamID int64, date time.Time) (int64, error) {
+	var current models.PlayerMembership
+	query := `SELECT id, from_date FROM player_memberships WHERE player_id = $1 AND to_date IS NULL`
+	err := tx.GetContext(ctx, &current, query, playerID)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return 0, fmt.Errorf("get current membership: %s", err)
+	case date.Before(current.FromDate):
+		return 0, NewValidationError(fmt.Sprintf("player %d joined the current team on %s", playerID, current.FromDate.Format("2006-01-02")))
+	default:
+		if _, err := tx.ExecContext(ctx, `UPDATE player_memberships SET to_date = $1 WHERE id = $2`, date, current.ID); err != nil {
+			return 0, fmt.Errorf("close current membership: %s", err)
+		}
+	}
+
+	var id int64
+	query = `INSERT INTO player_memberships (player_id, team_id, from_date) VALUES ($1, $2, $3) RETURNING id`
+	if err := tx.QueryRowxContext(ctx, query, playerID, teamID, date).Scan(&id); err != nil {
+		return 0, fmt.Errorf("insert new membership: %s", err)
+	}
+
+	return id, nil
+}
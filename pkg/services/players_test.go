@@ -0,0 +1,50 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestAvailableJerseyNumbers(t *testing.T) {
+	available := availableJerseyNumbers([]string{"1", "10", "99", "100", "abc"})
+
+	assert.Len(t, available, 96)
+	assert.Equal(t, 2, available[0])
+	assert.Equal(t, 98, available[len(available)-1])
+	assert.NotContains(t, available, 10)
+	assert.Contains(t, available, 11)
+}
+
+func TestNormalizePlayer(t *testing.T) {
+	player, err := normalizePlayer(models.Player{JerseyNumber: "07", Role: "CB", Nationality: "id"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, models.PositionDefender, player.Position)
+		assert.Equal(t, "ID", player.Nationality)
+		assert.Equal(t, "7", player.JerseyNumber)
+	}
+
+	player, err = normalizePlayer(models.Player{JerseyNumber: "9", Position: models.PositionForward})
+	if assert.NoError(t, err) {
+		assert.Equal(t, models.PositionForward, player.Position)
+	}
+
+	_, err = normalizePlayer(models.Player{JerseyNumber: "1", Position: models.PositionGoalkeeper, Role: "ST"})
+	assert.IsType(t, &ValidationError{}, err)
+
+	_, err = normalizePlayer(models.Player{JerseyNumber: "1", Nationality: "xx"})
+	assert.IsType(t, &ValidationError{}, err)
+}
+
+func TestNormalizeJerseyNumber(t *testing.T) {
+	for _, number := range []string{"1", "07", " 99 "} {
+		_, err := normalizeJerseyNumber(number)
+		assert.NoError(t, err, number)
+	}
+	for _, number := range []string{"", "0", "100", "-7", "7a", "seven"} {
+		_, err := normalizeJerseyNumber(number)
+		assert.IsType(t, &ValidationError{}, err, number)
+	}
+}
@@ -0,0 +1,25 @@
+package services
+
+import "strings"
+
+// conditions collects optional SQL conditions written with "?" bind
+// variables, to be rebound for the database driver once the query is built.
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+// add appends a condition and its arguments.
+func (c *conditions) add(clause string, args ...interface{}) {
+	c.clauses = append(c.clauses, clause)
+	c.args = append(c.args, args...)
+}
+
+// where returns the WHERE clause joining every condition, or an empty
+// string when there is none.
+func (c *conditions) where() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(c.clauses, " AND ")
+}
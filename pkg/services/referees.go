@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// matchWindow is the time a match occupies from its kickoff, including
+// half-time and stoppages. Two matches kicking off closer than that overlap.
+const matchWindow = 2 * time.Hour
+
+// officialTwiceConstraint prevents a referee from holding two roles in a match.
+const officialTwiceConstraint = "match_officials_match_id_referee_id_key"
+
+// RefereesService service interface.
+type RefereesService interface {
+	ListReferees(ctx context.Context) ([]models.Referee, error)
+	GetReferee(ctx context.Context, id int64) (models.Referee, error)
+	CreateReferee(ctx context.Context, referee models.Referee) (models.Referee, error)
+	DeleteReferee(ctx context.Context, id int64) error
+	UpdateReferee(ctx context.Context, referee models.Referee) (models.Referee, error)
+	ListMatchOfficials(ctx context.Context, matchID int64) ([]models.MatchOfficial, error)
+	AssignOfficial(ctx context.Context, official models.MatchOfficial) (models.MatchOfficial, error)
+	UnassignOfficial(ctx context.Context, matchID int64, role string) error
+	ListRefereeAssignments(ctx context.Context, refereeID int64) ([]models.RefereeAssignment, error)
+}
+
+type refereesService struct {
+	db *sqlx.DB
+}
+
+// NewRefereesService returns an initialized RefereesService implementation.
+func NewRefereesService(db *sqlx.DB) RefereesService {
+	return &refereesService{db: db}
+}
+
+func (s *refereesService) ListReferees(ctx context.Context) ([]models.Referee, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, nationality
+			, created_at
+			, updated_at
+		FROM referees`
+
+	var referees []models.Referee
+	if err := s.db.SelectContext(ctx, &referees, query); err != nil {
+		return nil, fmt.Errorf("get the list of referees: %s", err)
+	}
+
+	return referees, nil
+}
+
+func (s *refereesService) GetReferee(ctx context.Context, id int64) (models.Referee, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, nationality
+			, created_at
+			, updated_at
+		FROM referees
+		WHERE id = $1`
+
+	var referee models.Referee
+	if err := s.db.GetContext(ctx, &referee, query, id); err != nil {
+		return models.Referee{}, fmt.Errorf("get a referee: %s", err)
+	}
+
+	return referee, nil
+}
+
+func (s *refereesService) CreateReferee(ctx context.Context, referee models.Referee) (models.Referee, error) {
+	query := "INSERT INTO referees (name, nationality) VALUES ($1, $2) RETURNING id"
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, referee.Name, referee.Nationality).Scan(&id); err != nil {
+		return models.Referee{}, fmt.Errorf("insert new referee: %s", err)
+	}
+
+	newReferee, err := s.GetReferee(ctx, id)
+	if err != nil {
+		return models.Referee{}, fmt.Errorf("get new referee: %s", err)
+	}
+
+	return newReferee, nil
+}
+
+func (s *refereesService) DeleteReferee(ctx context.Context, id int64) error {
+	query := `DELETE FROM referees WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("delete a referee: %s", err)
+	}
+
+	return nil
+}
+
+func (s *refereesService) UpdateReferee(ctx context.Context, referee models.Referee) (models.Referee, error) {
+	query := `UPDATE referees SET name=$1, nationality=$2, updated_at=CURRENT_TIMESTAMP WHERE id=$3`
+
+	if _, err := s.db.ExecContext(ctx, query, referee.Name, referee.Nationality, referee.ID); err != nil {
+		return models.Referee{}, fmt.Errorf("update referee: %s", err)
+	}
+
+	updatedReferee, err := s.GetReferee(ctx, referee.ID)
+	if err != nil {
+		return models.Referee{}, fmt.Errorf("get referee: %s", err)
+	}
+
+	return updatedReferee, nil
+}
+
+func (s *refereesService) ListMatchOfficials(ctx context.Context, matchID int64) ([]models.MatchOfficial, error) {
+	query := `
+		SELECT
+			mo.match_id
+			, mo.referee_id
+			, r.name AS referee_name
+			, mo.role
+		FROM match_officials mo
+		JOIN referees r ON r.id = mo.referee_id
+		WHERE mo.match_id = $1
+		ORDER BY mo.role`
+
+	var officials []models.MatchOfficial
+	if err := s.db.SelectContext(ctx, &officials, query, matchID); err != nil {
+		return nil, fmt.Errorf("get the list of match officials: %s", err)
+	}
+
+	return officials, nil
+}
+
+func (s *refereesService) AssignOfficial(ctx context.Context, official models.MatchOfficial) (models.MatchOfficial, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.MatchOfficial{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the referee so that concurrent assignments are checked one
+	// after the other.
+	var refereeID int64
+	err = tx.GetContext(ctx, &refereeID, `SELECT id FROM referees WHERE id = $1 FOR UPDATE`, official.RefereeID)
+	if err == sql.ErrNoRows {
+		return models.MatchOfficial{}, NewValidationError(fmt.Sprintf("referee %d does not exist", official.RefereeID))
+	}
+	if err != nil {
+		return models.MatchOfficial{}, fmt.Errorf("lock referee: %s", err)
+	}
+
+	var kickoff time.Time
+	err = tx.GetContext(ctx, &kickoff, `SELECT kickoff FROM matches WHERE id = $1`, official.MatchID)
+	if err == sql.ErrNoRows {
+		return models.MatchOfficial{}, NewValidationError(fmt.Sprintf("match %d does not exist", official.MatchID))
+	}
+	if err != nil {
+		return models.MatchOfficial{}, fmt.Errorf("get the match kickoff: %s", err)
+	}
+
+	overlapping, err := overlappingAssignments(ctx, tx, official.RefereeID, official.MatchID, kickoff)
+	if err != nil {
+		return models.MatchOfficial{}, err
+	}
+	if len(overlapping) > 0 {
+		return models.MatchOfficial{}, NewConflictError(fmt.Sprintf(
+			"referee %d is already assigned to match %d kicking off at %s",
+			official.RefereeID, overlapping[0], kickoff.Format(time.RFC3339)))
+	}
+
+	query := `
+		INSERT INTO match_officials (match_id, referee_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (match_id, role) DO UPDATE SET referee_id = EXCLUDED.referee_id`
+
+	if _, err := tx.ExecContext(ctx, query, official.MatchID, official.RefereeID, official.Role); err != nil {
+		if isUniqueViolation(err, officialTwiceConstraint) {
+			return models.MatchOfficial{}, NewConflictError(fmt.Sprintf(
+				"referee %d already holds another role in match %d", official.RefereeID, official.MatchID))
+		}
+		return models.MatchOfficial{}, fmt.Errorf("assign match official: %s", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.MatchOfficial{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	officials, err := s.ListMatchOfficials(ctx, official.MatchID)
+	if err != nil {
+		return models.MatchOfficial{}, fmt.Errorf("get match official: %s", err)
+	}
+	for _, assigned := range officials {
+		if assigned.Role == official.Role {
+			return assigned, nil
+		}
+	}
+
+	return models.MatchOfficial{}, fmt.Errorf("get match official: no %s in match %d", official.Role, official.MatchID)
+}
+
+func (s *refereesService) UnassignOfficial(ctx context.Context, matchID int64, role string) error {
+	query := `DELETE FROM match_officials WHERE match_id = $1 AND role = $2`
+
+	if _, err := s.db.ExecContext(ctx, query, matchID, role); err != nil {
+		return fmt.Errorf("unassign match official: %s", err)
+	}
+
+	return nil
+}
+
+func (s *refereesService) ListRefereeAssignments(ctx context.Context, refereeID int64) ([]models.RefereeAssignment, error) {
+	query := `
+		SELECT
+			m.id
+			, m.competition_id
+			, m.season_id
+			, m.home_team_id
+			, m.away_team_id
+			, m.kickoff
+			, m.venue_id
+			, m.status
+			, m.home_score
+			, m.away_score
+			, m.created_at
+			, m.updated_at
+			, mo.role
+		FROM match_officials mo
+		JOIN matches m ON m.id = mo.match_id
+		WHERE mo.referee_id = $1
+		ORDER BY m.kickoff DESC`
+
+	var assignments []models.RefereeAssignment
+	if err := s.db.SelectContext(ctx, &assignments, query, refereeID); err != nil {
+		return nil, fmt.Errorf("get the list of referee assignments: %s", err)
+	}
+
+	return assignments, nil
+}
+
+// overlappingAssignments returns the other matches the referee is assigned
+// to that overlap a match kicking off at the given time.
+func overlappingAssignments(ctx context.Context, q sqlx.QueryerContext, refereeID, matchID int64, kickoff time.Time) ([]int64, error) {
+	query := `
+		SELECT m.id
+		FROM match_officials mo
+		JOIN matches m ON m.id = mo.match_id
+		WHERE mo.referee_id = $1
+			AND m.id <> $2
+			AND m.status NOT IN ($3, $4)
+			AND m.kickoff > $5
+			AND m.kickoff < $6
+		ORDER BY m.kickoff`
+
+	var matchIDs []int64
+	if err := sqlx.SelectContext(ctx, q, &matchIDs, query, refereeID, matchID, models.MatchPostponed, models.MatchCancelled,
+		kickoff.Add(-matchWindow), kickoff.Add(matchWindow)); err != nil {
+		return nil, fmt.Errorf("get the overlapping assignments: %s", err)
+	}
+
+	return matchIDs, nil
+}
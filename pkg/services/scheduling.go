@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"soccer/pkg/models"
+)
+
+// SchedulingRules stores the constraints matches are scheduled under.
+type SchedulingRules struct {
+	// MinRest is the shortest time allowed between the kickoffs of two
+	// matches of the same team.
+	MinRest time.Duration
+}
+
+// scheduleConflicts returns the conflicts between a match and the other
+// matches stored. Postponed and cancelled matches conflict with nothing.
+func scheduleConflicts(ctx context.Context, q sqlx.QueryerContext, match models.Match, rules SchedulingRules) ([]models.Conflict, error) {
+	if match.Status == models.MatchPostponed || match.Status == models.MatchCancelled {
+		return []models.Conflict{}, nil
+	}
+
+	dayStart := match.Kickoff.UTC().Truncate(24 * time.Hour)
+	query := `
+		SELECT
+			id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+		FROM matches
+		WHERE id <> $1
+			AND status NOT IN ($2, $3)
+			AND (
+				((home_team_id IN ($4, $5) OR away_team_id IN ($4, $5)) AND kickoff > $6 AND kickoff < $7)
+				OR (venue_id = $8 AND kickoff >= $9 AND kickoff < $10)
+			)
+		ORDER BY kickoff, id`
+
+	var others []models.Match
+	if err := sqlx.SelectContext(ctx, q, &others, query, match.ID, models.MatchPostponed, models.MatchCancelled,
+		match.HomeTeamID, match.AwayTeamID, match.Kickoff.Add(-rules.MinRest), match.Kickoff.Add(rules.MinRest),
+		match.VenueID, dayStart, dayStart.Add(24*time.Hour)); err != nil {
+		return nil, fmt.Errorf("get the neighbouring matches: %s", err)
+	}
+
+	conflicts := matchConflicts(match, others, rules)
+
+	// Only a stored match can have officials assigned already.
+	if match.ID == 0 {
+		return conflicts, nil
+	}
+
+	var refereeIDs []int64
+	officialsQuery := `SELECT referee_id FROM match_officials WHERE match_id = $1 ORDER BY referee_id`
+	if err := sqlx.SelectContext(ctx, q, &refereeIDs, officialsQuery, match.ID); err != nil {
+		return nil, fmt.Errorf("get the match officials: %s", err)
+	}
+
+	for _, refereeID := range refereeIDs {
+		matchIDs, err := overlappingAssignments(ctx, q, refereeID, match.ID, match.Kickoff)
+		if err != nil {
+			return nil, err
+		}
+		for _, matchID := range matchIDs {
+			conflicts = append(conflicts, models.Conflict{
+				Type:    models.ConflictOfficial,
+				MatchID: matchID,
+				Message: fmt.Sprintf("referee %d is already assigned to match %d", refereeID, matchID),
+			})
+		}
+	}
+
+	return conflicts, nil
+}
+
+// lockSchedule locks the given teams, then the given venues along with the
+// home venues of the teams, each in id order, so that concurrent changes to
+// their schedules are checked one after the other. It returns the home
+// venue of each team.
+func lockSchedule(ctx context.Context, tx *sqlx.Tx, teamIDs []int64, venueIDs ...int64) (map[int64]*int64, error) {
+	var teams []models.Team
+	teamsQuery := `SELECT id, venue_id FROM teams WHERE id = ANY($1) ORDER BY id FOR UPDATE`
+	if err := tx.SelectContext(ctx, &teams, teamsQuery, pq.Array(teamIDs)); err != nil {
+		return nil, fmt.Errorf("lock teams: %s", err)
+	}
+
+	homeVenues := make(map[int64]*int64, len(teams))
+	for _, team := range teams {
+		homeVenues[team.ID] = team.VenueID
+		if team.VenueID != nil {
+			venueIDs = append(venueIDs, *team.VenueID)
+		}
+	}
+
+	venuesQuery := `SELECT id FROM venues WHERE id = ANY($1) ORDER BY id FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, venuesQuery, pq.Array(venueIDs)); err != nil {
+		return nil, fmt.Errorf("lock venues: %s", err)
+	}
+
+	return homeVenues, nil
+}
+
+// lockMatchSchedule locks the teams and the venue of a match, see
+// lockSchedule.
+func lockMatchSchedule(ctx context.Context, tx *sqlx.Tx, match models.Match) error {
+	var venueIDs []int64
+	if match.VenueID != nil {
+		venueIDs = append(venueIDs, *match.VenueID)
+	}
+
+	_, err := lockSchedule(ctx, tx, []int64{match.HomeTeamID, match.AwayTeamID}, venueIDs...)
+	return err
+}
+
+// matchConflicts returns the conflicts between a match and the given
+// other matches over team rest and venue bookings, in the order of the
+// other matches.
+func matchConflicts(match models.Match, others []models.Match, rules SchedulingRules) []models.Conflict {
+	conflicts := []models.Conflict{}
+	for _, other := range others {
+		if other.ID == match.ID || other.Status == models.MatchPostponed || other.Status == models.MatchCancelled {
+			continue
+		}
+
+		gap := match.Kickoff.Sub(other.Kickoff)
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap < rules.MinRest {
+			for _, teamID := range []int64{match.HomeTeamID, match.AwayTeamID} {
+				if teamID == other.HomeTeamID || teamID == other.AwayTeamID {
+					conflicts = append(conflicts, models.Conflict{
+						Type:    models.ConflictTeamRest,
+						MatchID: other.ID,
+						Message: fmt.Sprintf("team %d plays match %d less than %s apart", teamID, other.ID, rules.MinRest),
+					})
+				}
+			}
+		}
+
+		if match.VenueID != nil && other.VenueID != nil && *match.VenueID == *other.VenueID &&
+			match.Kickoff.UTC().Truncate(24*time.Hour).Equal(other.Kickoff.UTC().Truncate(24*time.Hour)) {
+			conflicts = append(conflicts, models.Conflict{
+				Type:    models.ConflictVenue,
+				MatchID: other.ID,
+				Message: fmt.Sprintf("venue %d hosts match %d on the same day", *match.VenueID, other.ID),
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// rescheduled tells whether an update moves a match to another time or
+// place, between other teams, or back into the schedule after it was
+// postponed or cancelled.
+func rescheduled(current, updated models.Match) bool {
+	sameVenue := (current.VenueID == nil && updated.VenueID == nil) ||
+		(current.VenueID != nil && updated.VenueID != nil && *current.VenueID == *updated.VenueID)
+	wasOff := current.Status == models.MatchPostponed || current.Status == models.MatchCancelled
+	isOff := updated.Status == models.MatchPostponed || updated.Status == models.MatchCancelled
+	return !current.Kickoff.Equal(updated.Kickoff) || !sameVenue ||
+		current.HomeTeamID != updated.HomeTeamID || current.AwayTeamID != updated.AwayTeamID ||
+		(wasOff && !isOff)
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestMatchConflicts(t *testing.T) {
+	kickoff := time.Date(2020, time.April, 21, 15, 0, 0, 0, time.UTC)
+	venueID, otherVenueID := int64(4), int64(5)
+	match := models.Match{HomeTeamID: 1, AwayTeamID: 2, Kickoff: kickoff, VenueID: &venueID}
+	others := []models.Match{
+		{ID: 10, HomeTeamID: 3, AwayTeamID: 1, Kickoff: kickoff.Add(-47 * time.Hour), VenueID: &otherVenueID},
+		{ID: 11, HomeTeamID: 2, AwayTeamID: 1, Kickoff: kickoff.Add(24 * time.Hour)},
+		{ID: 12, HomeTeamID: 5, AwayTeamID: 6, Kickoff: kickoff.Add(-4 * time.Hour), VenueID: &venueID},
+		{ID: 13, HomeTeamID: 5, AwayTeamID: 6, Kickoff: kickoff.Add(9 * time.Hour), VenueID: &venueID},
+		{ID: 14, HomeTeamID: 1, AwayTeamID: 7, Kickoff: kickoff.Add(time.Hour), Status: models.MatchPostponed},
+		{ID: 15, HomeTeamID: 2, AwayTeamID: 8, Kickoff: kickoff.Add(48 * time.Hour)},
+	}
+
+	conflicts := matchConflicts(match, others, SchedulingRules{MinRest: 48 * time.Hour})
+
+	assert.Equal(t, []models.Conflict{
+		{Type: models.ConflictTeamRest, MatchID: 10, Message: "team 1 plays match 10 less than 48h0m0s apart"},
+		{Type: models.ConflictTeamRest, MatchID: 11, Message: "team 1 plays match 11 less than 48h0m0s apart"},
+		{Type: models.ConflictTeamRest, MatchID: 11, Message: "team 2 plays match 11 less than 48h0m0s apart"},
+		{Type: models.ConflictVenue, MatchID: 12, Message: "venue 4 hosts match 12 on the same day"},
+	}, conflicts)
+}
+
+func TestRescheduled(t *testing.T) {
+	kickoff := time.Date(2020, time.April, 21, 15, 0, 0, 0, time.UTC)
+	venueID, otherVenueID := int64(4), int64(5)
+	current := models.Match{HomeTeamID: 1, AwayTeamID: 2, Kickoff: kickoff, VenueID: &venueID, Status: models.MatchScheduled}
+
+	finished := current
+	finished.Status = models.MatchFinished
+	assert.False(t, rescheduled(current, finished))
+
+	moved := current
+	moved.Kickoff = kickoff.Add(time.Hour)
+	assert.True(t, rescheduled(current, moved))
+
+	relocated := current
+	relocated.VenueID = &otherVenueID
+	assert.True(t, rescheduled(current, relocated))
+
+	postponed := current
+	postponed.Status = models.MatchPostponed
+	assert.False(t, rescheduled(current, postponed))
+	assert.True(t, rescheduled(postponed, current))
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// SeasonsService service interface.
+type SeasonsService interface {
+	ListSeasons(ctx context.Context, competitionID int64) ([]models.Season, error)
+	GetSeason(ctx context.Context, id int64) (models.Season, error)
+	GetCurrentSeason(ctx context.Context, competitionID int64) (models.Season, error)
+	CreateSeason(ctx context.Context, season models.Season) (models.Season, error)
+	DeleteSeason(ctx context.Context, id int64) error
+	UpdateSeason(ctx context.Context, season models.Season) (models.Season, error)
+	ListSeasonTeams(ctx context.Context, seasonID int64) ([]models.Team, error)
+	RegisterTeam(ctx context.Context, seasonTeam models.SeasonTeam) error
+	UnregisterTeam(ctx context.Context, seasonTeam models.SeasonTeam) error
+}
+
+type seasonsService struct {
+	db *sqlx.DB
+}
+
+// NewSeasonsService returns an initialized SeasonsService implementation.
+func NewSeasonsService(db *sqlx.DB) SeasonsService {
+	return &seasonsService{db: db}
+}
+
+func (s *seasonsService) ListSeasons(ctx context.Context, competitionID int64) ([]models.Season, error) {
+	query := `
+		SELECT
+			id
+			, competition_id
+			, name
+			, start_date
+			, end_date
+			, created_at
+			, updated_at
+		FROM seasons
+		WHERE competition_id = $1
+		ORDER BY start_date`
+
+	var seasons []models.Season
+	if err := s.db.SelectContext(ctx, &seasons, query, competitionID); err != nil {
+		return nil, fmt.Errorf("get the list of seasons: %s", err)
+	}
+
+	return seasons, nil
+}
+
+func (s *seasonsService) GetSeason(ctx context.Context, id int64) (models.Season, error) {
+	query := `
+		SELECT
+			id
+			, competition_id
+			, name
+			, start_date
+			, end_date
+			, created_at
+			, updated_at
+		FROM seasons
+		WHERE id = $1`
+
+	var season models.Season
+	if err := s.db.GetContext(ctx, &season, query, id); err != nil {
+		return models.Season{}, fmt.Errorf("get a season: %s", err)
+	}
+
+	return season, nil
+}
+
+// GetCurrentSeason returns the latest season of a competition to have
+// started, or its next one when none has started yet.
+func (s *seasonsService) GetCurrentSeason(ctx context.Context, competitionID int64) (models.Season, error) {
+	query := `
+		SELECT
+			id
+			, competition_id
+			, name
+			, start_date
+			, end_date
+			, created_at
+			, updated_at
+		FROM seasons
+		WHERE competition_id = $1
+		ORDER BY start_date > CURRENT_DATE, ABS(start_date - CURRENT_DATE)
+		LIMIT 1`
+
+	var season models.Season
+	if err := s.db.GetContext(ctx, &season, query, competitionID); err != nil {
+		return models.Season{}, fmt.Errorf("get the current season: %s", err)
+	}
+
+	return season, nil
+}
+
+func (s *seasonsService) CreateSeason(ctx context.Context, season models.Season) (models.Season, error) {
+	query := `
+		INSERT INTO seasons (competition_id, name, start_date, end_date)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id`
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, season.CompetitionID, season.Name,
+		season.StartDate, season.EndDate).Scan(&id); err != nil {
+		return models.Season{}, fmt.Errorf("insert new season: %s", err)
+	}
+
+	newSeason, err := s.GetSeason(ctx, id)
+	if err != nil {
+		return models.Season{}, fmt.Errorf("get new season: %s", err)
+	}
+
+	return newSeason, nil
+}
+
+func (s *seasonsService) DeleteSeason(ctx context.Context, id int64) error {
+	query := `DELETE FROM seasons WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("delete a season: %s", err)
+	}
+
+	return nil
+}
+
+func (s *seasonsService) UpdateSeason(ctx context.Context, season models.Season) (models.Season, error) {
+	query := `
+		UPDATE seasons SET
+			competition_id=$1
+			, name=$2
+			, start_date=$3
+			, end_date=$4
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$5`
+
+	if _, err := s.db.ExecContext(ctx, query, season.CompetitionID, season.Name,
+		season.StartDate, season.EndDate, season.ID); err != nil {
+		return models.Season{}, fmt.Errorf("update season: %s", err)
+	}
+
+	updatedSeason, err := s.GetSeason(ctx, season.ID)
+	if err != nil {
+		return models.Season{}, fmt.Errorf("get season: %s", err)
+	}
+
+	return updatedSeason, nil
+}
+
+func (s *seasonsService) ListSeasonTeams(ctx context.Context, seasonID int64) ([]models.Team, error) {
+	query := `
+		SELECT
+			t.id
+			, t.name
+			, t.description
+			, t.venue_id
+			, t.created_at
+			, t.updated_at
+		FROM teams t
+		JOIN season_teams st ON st.team_id = t.id
+		WHERE st.season_id = $1
+		ORDER BY t.name`
+
+	var teams []models.Team
+	if err := s.db.SelectContext(ctx, &teams, query, seasonID); err != nil {
+		return nil, fmt.Errorf("get the list of season teams: %s", err)
+	}
+
+	return teams, nil
+}
+
+func (s *seasonsService) RegisterTeam(ctx context.Context, seasonTeam models.SeasonTeam) error {
+	query := `
+		INSERT INTO season_teams (season_id, team_id)
+		VALUES ($1, $2)
+		ON CONFLICT (season_id, team_id) DO NOTHING`
+
+	if _, err := s.db.ExecContext(ctx, query, seasonTeam.SeasonID, seasonTeam.TeamID); err != nil {
+		return fmt.Errorf("register team in season: %s", err)
+	}
+
+	return nil
+}
+
+func (s *seasonsService) UnregisterTeam(ctx context.Context, seasonTeam models.SeasonTeam) error {
+	query := `DELETE FROM season_teams WHERE season_id = $1 AND team_id = $2`
+
+	if _, err := s.db.ExecContext(ctx, query, seasonTeam.SeasonID, seasonTeam.TeamID); err != nil {
+		return fmt.Errorf("unregister team from season: %s", err)
+	}
+
+	return nil
+}
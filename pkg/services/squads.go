@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// SquadsService service interface.
+type SquadsService interface {
+	GetSquadRules(ctx context.Context, competitionID int64) (models.SquadRules, error)
+	SaveSquadRules(ctx context.Context, rules models.SquadRules) (models.SquadRules, error)
+	ListSquad(ctx context.Context, teamID, seasonID int64) ([]models.SquadRegistration, error)
+	RegisterPlayer(ctx context.Context, registration models.SquadRegistration) (models.SquadRegistration, error)
+	UnregisterPlayer(ctx context.Context, teamID, seasonID, playerID int64) error
+	GetSquadCompliance(ctx context.Context, teamID, seasonID int64) (models.SquadCompliance, error)
+}
+
+type squadsService struct {
+	db *sqlx.DB
+}
+
+// NewSquadsService returns an initialized SquadsService implementation.
+func NewSquadsService(db *sqlx.DB) SquadsService {
+	return &squadsService{db: db}
+}
+
+func (s *squadsService) GetSquadRules(ctx context.Context, competitionID int64) (models.SquadRules, error) {
+	rules, err := getSquadRules(ctx, s.db, `WHERE competition_id = $1`, competitionID)
+	if err != nil {
+		return models.SquadRules{}, err
+	}
+	rules.CompetitionID = competitionID
+
+	return rules, nil
+}
+
+func (s *squadsService) SaveSquadRules(ctx context.Context, rules models.SquadRules) (models.SquadRules, error) {
+	if err := validateSquadRules(rules); err != nil {
+		return models.SquadRules{}, err
+	}
+
+	query := `
+		INSERT INTO squad_rules (competition_id, max_squad_size, min_homegrown, max_non_nationals, nationality)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (competition_id) DO UPDATE SET
+			max_squad_size = EXCLUDED.max_squad_size
+			, min_homegrown = EXCLUDED.min_homegrown
+			, max_non_nationals = EXCLUDED.max_non_nationals
+			, nationality = EXCLUDED.nationality
+			, updated_at = CURRENT_TIMESTAMP`
+
+	if _, err := s.db.ExecContext(ctx, query, rules.CompetitionID, rules.MaxSquadSize, rules.MinHomegrown,
+		rules.MaxNonNationals, strings.ToUpper(rules.Nationality)); err != nil {
+		return models.SquadRules{}, fmt.Errorf("save squad rules: %s", err)
+	}
+
+	savedRules, err := s.GetSquadRules(ctx, rules.CompetitionID)
+	if err != nil {
+		return models.SquadRules{}, fmt.Errorf("get squad rules: %s", err)
+	}
+
+	return savedRules, nil
+}
+
+func (s *squadsService) ListSquad(ctx context.Context, teamID, seasonID int64) ([]models.SquadRegistration, error) {
+	return listSquad(ctx, s.db, teamID, seasonID)
+}
+
+func (s *squadsService) RegisterPlayer(ctx context.Context, registration models.SquadRegistration) (models.SquadRegistration, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.SquadRegistration{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the team's season entry so that concurrent registrations are
+	// checked against the rules one after the other.
+	var seasonTeam models.SeasonTeam
+	seasonTeamQuery := `SELECT season_id, team_id FROM season_teams WHERE season_id = $1 AND team_id = $2 FOR UPDATE`
+	err = tx.GetContext(ctx, &seasonTeam, seasonTeamQuery, registration.SeasonID, registration.TeamID)
+	if err == sql.ErrNoRows {
+		return models.SquadRegistration{}, NewValidationError(fmt.Sprintf("team %d is not registered in season %d", registration.TeamID, registration.SeasonID))
+	}
+	if err != nil {
+		return models.SquadRegistration{}, fmt.Errorf("get the season team: %s", err)
+	}
+
+	var player models.Player
+	if err := tx.GetContext(ctx, &player, `SELECT id, team_id, name, nationality FROM players WHERE id = $1`, registration.PlayerID); err != nil {
+		return models.SquadRegistration{}, fmt.Errorf("get the player: %s", err)
+	}
+	if player.TeamID != registration.TeamID {
+		return models.SquadRegistration{}, NewValidationError(fmt.Sprintf("player %d does not play for team %d", player.ID, registration.TeamID))
+	}
+
+	var registeredBy int64
+	registeredQuery := `SELECT team_id FROM squad_registrations WHERE season_id = $1 AND player_id = $2`
+	err = tx.GetContext(ctx, &registeredBy, registeredQuery, registration.SeasonID, registration.PlayerID)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return models.SquadRegistration{}, fmt.Errorf("get the player registration: %s", err)
+	case registeredBy != registration.TeamID:
+		return models.SquadRegistration{}, NewConflictError(fmt.Sprintf("player %d is already registered by team %d in season %d",
+			player.ID, registeredBy, registration.SeasonID))
+	}
+
+	rules, err := getSquadRules(ctx, tx, `JOIN seasons s ON s.competition_id = r.competition_id WHERE s.id = $1`, registration.SeasonID)
+	if err != nil {
+		return models.SquadRegistration{}, err
+	}
+
+	squad, err := listSquad(ctx, tx, registration.TeamID, registration.SeasonID)
+	if err != nil {
+		return models.SquadRegistration{}, err
+	}
+
+	registration.PlayerName = player.Name
+	registration.Nationality = player.Nationality
+	if problems := registrationViolations(rules, squad, registration); len(problems) > 0 {
+		return models.SquadRegistration{}, NewValidationError(problems...)
+	}
+
+	query := `
+		INSERT INTO squad_registrations (season_id, team_id, player_id, homegrown)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (season_id, player_id) DO UPDATE SET homegrown = EXCLUDED.homegrown`
+
+	if _, err := tx.ExecContext(ctx, query, registration.SeasonID, registration.TeamID, registration.PlayerID,
+		registration.Homegrown); err != nil {
+		return models.SquadRegistration{}, fmt.Errorf("register player in squad: %s", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.SquadRegistration{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	return registration, nil
+}
+
+func (s *squadsService) UnregisterPlayer(ctx context.Context, teamID, seasonID, playerID int64) error {
+	query := `DELETE FROM squad_registrations WHERE team_id = $1 AND season_id = $2 AND player_id = $3`
+
+	if _, err := s.db.ExecContext(ctx, query, teamID, seasonID, playerID); err != nil {
+		return fmt.Errorf("unregister player from squad: %s", err)
+	}
+
+	return nil
+}
+
+func (s *squadsService) GetSquadCompliance(ctx context.Context, teamID, seasonID int64) (models.SquadCompliance, error) {
+	rules, err := getSquadRules(ctx, s.db, `JOIN seasons s ON s.competition_id = r.competition_id WHERE s.id = $1`, seasonID)
+	if err != nil {
+		return models.SquadCompliance{}, err
+	}
+
+	squad, err := listSquad(ctx, s.db, teamID, seasonID)
+	if err != nil {
+		return models.SquadCompliance{}, err
+	}
+
+	return squadCompliance(teamID, seasonID, rules, squad), nil
+}
+
+// getSquadRules returns the squad rules selected by the given clause, or no
+// rules at all when the competition has none.
+func getSquadRules(ctx context.Context, db sqlx.QueryerContext, clause string, args ...interface{}) (models.SquadRules, error) {
+	query := `
+		SELECT
+			r.competition_id
+			, r.max_squad_size
+			, r.min_homegrown
+			, r.max_non_nationals
+			, r.nationality
+		FROM squad_rules r ` + clause
+
+	var rules models.SquadRules
+	err := sqlx.GetContext(ctx, db, &rules, query, args...)
+	if err == sql.ErrNoRows {
+		return models.SquadRules{}, nil
+	}
+	if err != nil {
+		return models.SquadRules{}, fmt.Errorf("get squad rules: %s", err)
+	}
+
+	return rules, nil
+}
+
+// listSquad returns the players registered by a team for a season.
+func listSquad(ctx context.Context, db sqlx.QueryerContext, teamID, seasonID int64) ([]models.SquadRegistration, error) {
+	query := `
+		SELECT
+			r.season_id
+			, r.team_id
+			, r.player_id
+			, r.homegrown
+			, p.name AS player_name
+			, p.nationality
+		FROM squad_registrations r
+		JOIN players p ON p.id = r.player_id
+		WHERE r.team_id = $1 AND r.season_id = $2
+		ORDER BY p.name`
+
+	var squad []models.SquadRegistration
+	if err := sqlx.SelectContext(ctx, db, &squad, query, teamID, seasonID); err != nil {
+		return nil, fmt.Errorf("get the squad: %s", err)
+	}
+
+	return squad, nil
+}
+
+// validateSquadRules checks that the rules can be met.
+func validateSquadRules(rules models.SquadRules) error {
+	var problems []string
+
+	limits := []struct {
+		name  string
+		value *int
+	}{
+		{"max_squad_size", rules.MaxSquadSize},
+		{"min_homegrown", rules.MinHomegrown},
+		{"max_non_nationals", rules.MaxNonNationals},
+	}
+	for _, limit := range limits {
+		if limit.value != nil && *limit.value < 0 {
+			problems = append(problems, fmt.Sprintf("%s must not be negative", limit.name))
+		}
+	}
+	if rules.MaxSquadSize != nil && rules.MinHomegrown != nil && *rules.MinHomegrown > *rules.MaxSquadSize {
+		problems = append(problems, "min_homegrown must not exceed max_squad_size")
+	}
+	if rules.MaxNonNationals != nil && rules.Nationality == "" {
+		problems = append(problems, "nationality is required to cap non-nationals")
+	}
+
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+	return nil
+}
+
+// isNonNational reports whether a registered player counts as a
+// non-national. Players of unknown nationality do not.
+func isNonNational(rules models.SquadRules, registration models.SquadRegistration) bool {
+	return registration.Nationality != "" && !strings.EqualFold(registration.Nationality, rules.Nationality)
+}
+
+// squadCounts returns the size of a squad, and how many of its players are
+// homegrown and non-nationals.
+func squadCounts(rules models.SquadRules, squad []models.SquadRegistration) (size, homegrown, nonNationals int) {
+	for _, registration := range squad {
+		if registration.Homegrown {
+			homegrown++
+		}
+		if isNonNational(rules, registration) {
+			nonNationals++
+		}
+	}
+	return len(squad), homegrown, nonNationals
+}
+
+// squadCompliance checks a squad against every rule.
+func squadCompliance(teamID, seasonID int64, rules models.SquadRules, squad []models.SquadRegistration) models.SquadCompliance {
+	size, homegrown, nonNationals := squadCounts(rules, squad)
+
+	violations := []string{}
+	if rules.MaxSquadSize != nil && size > *rules.MaxSquadSize {
+		violations = append(violations, fmt.Sprintf("squad has %d players, more than the maximum of %d", size, *rules.MaxSquadSize))
+	}
+	if rules.MinHomegrown != nil && homegrown < *rules.MinHomegrown {
+		violations = append(violations, fmt.Sprintf("squad has %d homegrown players, fewer than the minimum of %d", homegrown, *rules.MinHomegrown))
+	}
+	if rules.MaxNonNationals != nil && nonNationals > *rules.MaxNonNationals {
+		violations = append(violations, fmt.Sprintf("squad has %d non-nationals, more than the maximum of %d", nonNationals, *rules.MaxNonNationals))
+	}
+
+	return models.SquadCompliance{
+		TeamID:       teamID,
+		SeasonID:     seasonID,
+		Rules:        rules,
+		SquadSize:    size,
+		Homegrown:    homegrown,
+		NonNationals: nonNationals,
+		Compliant:    len(violations) == 0,
+		Violations:   violations,
+	}
+}
+
+// registrationViolations lists the rules a squad would break once the
+// player is registered. Only the rules the player counts towards apply, so
+// a squad that broke rules since tightened can still be brought back in
+// line. As a squad is built one player at a time, the homegrown minimum only
+// rejects a player who would leave too few places for the homegrown players
+// still missing.
+func registrationViolations(rules models.SquadRules, squad []models.SquadRegistration, registration models.SquadRegistration) []string {
+	next := make([]models.SquadRegistration, 0, len(squad)+1)
+	for _, registered := range squad {
+		if registered.PlayerID != registration.PlayerID {
+			next = append(next, registered)
+		}
+	}
+	next = append(next, registration)
+
+	size, homegrown, nonNationals := squadCounts(rules, next)
+
+	var problems []string
+	if size > len(squad) && rules.MaxSquadSize != nil && size > *rules.MaxSquadSize {
+		problems = append(problems, fmt.Sprintf("squad is full with %d players", *rules.MaxSquadSize))
+	}
+	if !registration.Homegrown && rules.MaxSquadSize != nil && rules.MinHomegrown != nil &&
+		size-homegrown > *rules.MaxSquadSize-*rules.MinHomegrown {
+		problems = append(problems, fmt.Sprintf("squad must keep %d places for homegrown players", *rules.MinHomegrown))
+	}
+	if isNonNational(rules, registration) && rules.MaxNonNationals != nil && nonNationals > *rules.MaxNonNationals {
+		problems = append(problems, fmt.Sprintf("squad already has the maximum of %d non-nationals", *rules.MaxNonNationals))
+	}
+
+	return problems
+}
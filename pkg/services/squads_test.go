@@ -0,0 +1,74 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestSquadCompliance(t *testing.T) {
+	rules := models.SquadRules{MaxSquadSize: intPtr(3), MinHomegrown: intPtr(2), MaxNonNationals: intPtr(1), Nationality: "ID"}
+	squad := []models.SquadRegistration{
+		{PlayerID: 1, Homegrown: true, Nationality: "ID"},
+		{PlayerID: 2, Nationality: "BR"},
+		{PlayerID: 3, Nationality: "jp"},
+		{PlayerID: 4},
+	}
+
+	compliance := squadCompliance(1, 2, rules, squad)
+
+	assert.Equal(t, models.SquadCompliance{
+		TeamID:       1,
+		SeasonID:     2,
+		Rules:        rules,
+		SquadSize:    4,
+		Homegrown:    1,
+		NonNationals: 2,
+		Violations: []string{
+			"squad has 4 players, more than the maximum of 3",
+			"squad has 1 homegrown players, fewer than the minimum of 2",
+			"squad has 2 non-nationals, more than the maximum of 1",
+		},
+	}, compliance)
+
+	assert.Equal(t, models.SquadCompliance{Violations: []string{}, Compliant: true}, squadCompliance(0, 0, models.SquadRules{}, nil))
+}
+
+func TestRegistrationViolations(t *testing.T) {
+	rules := models.SquadRules{MaxSquadSize: intPtr(4), MinHomegrown: intPtr(2), MaxNonNationals: intPtr(1), Nationality: "ID"}
+	squad := []models.SquadRegistration{
+		{PlayerID: 1, Homegrown: true, Nationality: "ID"},
+		{PlayerID: 2, Nationality: "BR"},
+	}
+
+	assert.Empty(t, registrationViolations(rules, squad, models.SquadRegistration{PlayerID: 3, Homegrown: true, Nationality: "ID"}))
+	assert.Empty(t, registrationViolations(rules, squad, models.SquadRegistration{PlayerID: 2, Homegrown: true, Nationality: "BR"}))
+	assert.Equal(t, []string{
+		"squad already has the maximum of 1 non-nationals",
+	}, registrationViolations(rules, squad, models.SquadRegistration{PlayerID: 3, Nationality: "JP"}))
+
+	squad = append(squad, models.SquadRegistration{PlayerID: 3, Nationality: "ID"})
+	assert.Equal(t, []string{
+		"squad must keep 2 places for homegrown players",
+	}, registrationViolations(rules, squad, models.SquadRegistration{PlayerID: 4, Nationality: "ID"}))
+
+	squad = append(squad, models.SquadRegistration{PlayerID: 4, Homegrown: true, Nationality: "ID"})
+	assert.Equal(t, []string{
+		"squad is full with 4 players",
+	}, registrationViolations(rules, squad, models.SquadRegistration{PlayerID: 5, Homegrown: true, Nationality: "ID"}))
+}
+
+func TestValidateSquadRules(t *testing.T) {
+	assert.NoError(t, validateSquadRules(models.SquadRules{}))
+	assert.Equal(t, NewValidationError(
+		"max_squad_size must not be negative",
+		"min_homegrown must not exceed max_squad_size",
+		"nationality is required to cap non-nationals",
+	), validateSquadRules(models.SquadRules{MaxSquadSize: intPtr(-1), MinHomegrown: intPtr(2), MaxNonNationals: intPtr(3)}))
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// StaffService service interface.
+type StaffService interface {
+	ListStaff(ctx context.Context, teamID int64) ([]models.Staff, error)
+	GetStaff(ctx context.Context, teamID, id int64) (models.Staff, error)
+	CreateStaff(ctx context.Context, staff models.Staff) (models.Staff, error)
+	DeleteStaff(ctx context.Context, teamID, id int64) error
+	UpdateStaff(ctx context.Context, staff models.Staff) (models.Staff, error)
+	ListCoachRecords(ctx context.Context, teamID int64) ([]models.CoachRecord, error)
+}
+
+type staffService struct {
+	db *sqlx.DB
+}
+
+// NewStaffService returns an initialized StaffService implementation.
+func NewStaffService(db *sqlx.DB) StaffService {
+	return &staffService{db: db}
+}
+
+func (s *staffService) ListStaff(ctx context.Context, teamID int64) ([]models.Staff, error) {
+	query := `
+		SELECT
+			id
+			, team_id
+			, name
+			, role
+			, start_date
+			, end_date
+			, created_at
+			, updated_at
+		FROM staff
+		WHERE team_id = $1
+		ORDER BY start_date DESC`
+
+	var staff []models.Staff
+	if err := s.db.SelectContext(ctx, &staff, query, teamID); err != nil {
+		return nil, fmt.Errorf("get the list of staff: %s", err)
+	}
+
+	return staff, nil
+}
+
+func (s *staffService) GetStaff(ctx context.Context, teamID, id int64) (models.Staff, error) {
+	query := `
+		SELECT
+			id
+			, team_id
+			, name
+			, role
+			, start_date
+			, end_date
+			, created_at
+			, updated_at
+		FROM staff
+		WHERE team_id = $1 AND id = $2`
+
+	var staff models.Staff
+	if err := s.db.GetContext(ctx, &staff, query, teamID, id); err != nil {
+		return models.Staff{}, fmt.Errorf("get a staff member: %s", err)
+	}
+
+	return staff, nil
+}
+
+func (s *staffService) CreateStaff(ctx context.Context, staff models.Staff) (models.Staff, error) {
+	query := `
+		INSERT INTO staff (team_id, name, role, start_date, end_date)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, staff.TeamID, staff.Name, staff.Role,
+		staff.StartDate, staff.EndDate).Scan(&id); err != nil {
+		return models.Staff{}, fmt.Errorf("insert new staff member: %s", err)
+	}
+
+	newStaff, err := s.GetStaff(ctx, staff.TeamID, id)
+	if err != nil {
+		return models.Staff{}, fmt.Errorf("get new staff member: %s", err)
+	}
+
+	return newStaff, nil
+}
+
+func (s *staffService) DeleteStaff(ctx context.Context, teamID, id int64) error {
+	query := `DELETE FROM staff WHERE team_id = $1 AND id = $2`
+
+	if _, err := s.db.ExecContext(ctx, query, teamID, id); err != nil {
+		return fmt.Errorf("delete a staff member: %s", err)
+	}
+
+	return nil
+}
+
+func (s *staffService) UpdateStaff(ctx context.Context, staff models.Staff) (models.Staff, error) {
+	query := `
+		UPDATE staff SET
+			name=$1
+			, role=$2
+			, start_date=$3
+			, end_date=$4
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE team_id=$5 AND id=$6`
+
+	if _, err := s.db.ExecContext(ctx, query, staff.Name, staff.Role, staff.StartDate, staff.EndDate,
+		staff.TeamID, staff.ID); err != nil {
+		return models.Staff{}, fmt.Errorf("update staff member: %s", err)
+	}
+
+	updatedStaff, err := s.GetStaff(ctx, staff.TeamID, staff.ID)
+	if err != nil {
+		return models.Staff{}, fmt.Errorf("get staff member: %s", err)
+	}
+
+	return updatedStaff, nil
+}
+
+func (s *staffService) ListCoachRecords(ctx context.Context, teamID int64) ([]models.CoachRecord, error) {
+	coachesQuery := `
+		SELECT
+			id
+			, team_id
+			, name
+			, role
+			, start_date
+			, end_date
+		FROM staff
+		WHERE team_id = $1 AND role = $2
+		ORDER BY start_date`
+
+	var coaches []models.Staff
+	if err := s.db.SelectContext(ctx, &coaches, coachesQuery, teamID, models.StaffHeadCoach); err != nil {
+		return nil, fmt.Errorf("get the list of head coaches: %s", err)
+	}
+
+	matchesQuery := `
+		SELECT
+			id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, status
+			, home_score
+			, away_score
+		FROM matches
+		WHERE status = $1 AND (home_team_id = $2 OR away_team_id = $2)
+		ORDER BY kickoff`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, matchesQuery, models.MatchFinished, teamID); err != nil {
+		return nil, fmt.Errorf("get the list of team matches: %s", err)
+	}
+
+	return coachRecords(teamID, coaches, matches), nil
+}
+
+// coachRecords computes the team's record during each coach's tenure from
+// its finished matches. Tenure dates are inclusive.
+func coachRecords(teamID int64, coaches []models.Staff, matches []models.Match) []models.CoachRecord {
+	records := make([]models.CoachRecord, 0, len(coaches))
+	for _, coach := range coaches {
+		record := models.CoachRecord{
+			StaffID:   coach.ID,
+			Name:      coach.Name,
+			StartDate: coach.StartDate,
+			EndDate:   coach.EndDate,
+		}
+
+		for _, match := range matches {
+			if match.Status != models.MatchFinished || match.Kickoff.Before(coach.StartDate) {
+				continue
+			}
+			if coach.EndDate != nil && !match.Kickoff.Before(coach.EndDate.AddDate(0, 0, 1)) {
+				continue
+			}
+
+			scored, conceded := match.ScoreFor(teamID)
+			record.Played++
+			record.GoalsFor += scored
+			record.GoalsAgainst += conceded
+			switch match.ResultFor(teamID) {
+			case models.ResultWin:
+				record.Won++
+			case models.ResultDraw:
+				record.Drawn++
+			case models.ResultLoss:
+				record.Lost++
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestCoachRecords(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2020, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	playedOn := func(match models.Match, kickoff time.Time) models.Match {
+		match.Kickoff = kickoff
+		return match
+	}
+
+	sacked := date(time.March, 1)
+	coaches := []models.Staff{
+		{ID: 1, Name: "Old", Role: models.StaffHeadCoach, StartDate: date(time.January, 1), EndDate: &sacked},
+		{ID: 2, Name: "New", Role: models.StaffHeadCoach, StartDate: date(time.March, 2)},
+	}
+	matches := []models.Match{
+		playedOn(finishedMatch(1, 2, 0, 1), date(time.February, 1)),
+		playedOn(finishedMatch(3, 1, 2, 2), date(time.March, 1).Add(19*time.Hour)),
+		playedOn(finishedMatch(1, 3, 3, 0), date(time.March, 10)),
+		{HomeTeamID: 1, AwayTeamID: 2, Kickoff: date(time.April, 1), Status: models.MatchScheduled},
+	}
+
+	records := coachRecords(1, coaches, matches)
+
+	assert.Equal(t, []models.CoachRecord{
+		{StaffID: 1, Name: "Old", StartDate: date(time.January, 1), EndDate: &sacked, Played: 2, Drawn: 1, Lost: 1, GoalsFor: 2, GoalsAgainst: 3},
+		{StaffID: 2, Name: "New", StartDate: date(time.March, 2), Played: 1, Won: 1, GoalsFor: 3},
+	}, records)
+}
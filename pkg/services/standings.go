@@ -0,0 +1,506 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// formLength is the number of latest results in a form guide.
+const formLength = 5
+
+// defaultTiebreakers apply to competitions without standings rules.
+var defaultTiebreakers = []string{models.TiebreakerGoalDifference, models.TiebreakerGoalsScored}
+
+// tiebreakers lists every supported tiebreaker.
+var tiebreakers = []string{
+	models.TiebreakerGoalDifference,
+	models.TiebreakerGoalsScored,
+	models.TiebreakerHeadToHeadPoints,
+	models.TiebreakerHeadToHeadGoalDifference,
+	models.TiebreakerHeadToHeadGoalsScored,
+	models.TiebreakerAwayGoals,
+	models.TiebreakerFairPlay,
+	models.TiebreakerDrawingOfLots,
+}
+
+// fairPlayDeductions are the fair-play points a team loses per card. A
+// second yellow costs two more on top of the first yellow, and a straight
+// red four, so being sent off after a booking costs three or five in all.
+var fairPlayDeductions = map[string]int{
+	models.EventYellowCard:   1,
+	models.EventSecondYellow: 2,
+	models.EventRedCard:      4,
+}
+
+// PointsSystem stores the points awarded for each match result.
+type PointsSystem struct {
+	Win  int
+	Draw int
+	Loss int
+}
+
+// StandingsService service interface.
+type StandingsService interface {
+	GetStandings(ctx context.Context, competitionID int64, seasonID *int64) ([]models.Standing, error)
+	GetStandingsRules(ctx context.Context, competitionID int64) (models.StandingsRules, error)
+	SaveStandingsRules(ctx context.Context, rules models.StandingsRules) (models.StandingsRules, error)
+}
+
+type standingsService struct {
+	db     *sqlx.DB
+	points PointsSystem
+}
+
+// NewStandingsService returns an initialized StandingsService implementation.
+func NewStandingsService(db *sqlx.DB, points PointsSystem) StandingsService {
+	return &standingsService{db: db, points: points}
+}
+
+func (s *standingsService) GetStandings(ctx context.Context, competitionID int64, seasonID *int64) ([]models.Standing, error) {
+	teamsQuery := `
+		SELECT DISTINCT
+			t.id
+			, t.name
+		FROM teams t
+		JOIN matches m ON t.id IN (m.home_team_id, m.away_team_id)
+		WHERE m.competition_id = $1`
+	teamsArgs := []interface{}{competitionID}
+	if seasonID != nil {
+		teamsQuery = `
+			SELECT
+				t.id
+				, t.name
+			FROM teams t
+			JOIN season_teams st ON st.team_id = t.id
+			JOIN seasons s ON s.id = st.season_id
+			WHERE s.competition_id = $1 AND s.id = $2`
+		teamsArgs = append(teamsArgs, *seasonID)
+	}
+
+	var teams []models.Team
+	if err := s.db.SelectContext(ctx, &teams, teamsQuery, teamsArgs...); err != nil {
+		return nil, fmt.Errorf("get the list of competition teams: %s", err)
+	}
+
+	var conds conditions
+	conds.add("competition_id = ?", competitionID)
+	conds.add("status = ?", models.MatchFinished)
+	if seasonID != nil {
+		conds.add("season_id = ?", *seasonID)
+	}
+
+	matchesQuery := `
+		SELECT
+			id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, status
+			, home_score
+			, away_score
+		FROM matches` + conds.where() + `
+		ORDER BY kickoff`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, s.db.Rebind(matchesQuery), conds.args...); err != nil {
+		return nil, fmt.Errorf("get the list of finished matches: %s", err)
+	}
+
+	rules, err := s.GetStandingsRules(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ties := tiebreaks{order: rules.Tiebreakers, seed: fmt.Sprintf("%d", competitionID)}
+	if seasonID != nil {
+		ties.seed = fmt.Sprintf("%d:%d", competitionID, *seasonID)
+	}
+	for _, tiebreaker := range ties.order {
+		if tiebreaker == models.TiebreakerFairPlay {
+			if ties.fairPlay, err = s.fairPlayPoints(ctx, competitionID, seasonID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return computeStandings(teams, matches, s.points, ties), nil
+}
+
+func (s *standingsService) GetStandingsRules(ctx context.Context, competitionID int64) (models.StandingsRules, error) {
+	query := `
+		SELECT tiebreaker
+		FROM standings_tiebreakers
+		WHERE competition_id = $1
+		ORDER BY priority`
+
+	rules := models.StandingsRules{CompetitionID: competitionID}
+	if err := s.db.SelectContext(ctx, &rules.Tiebreakers, query, competitionID); err != nil {
+		return models.StandingsRules{}, fmt.Errorf("get standings rules: %s", err)
+	}
+	if len(rules.Tiebreakers) == 0 {
+		rules.Tiebreakers = defaultTiebreakers
+	}
+
+	return rules, nil
+}
+
+func (s *standingsService) SaveStandingsRules(ctx context.Context, rules models.StandingsRules) (models.StandingsRules, error) {
+	if err := validateStandingsRules(rules); err != nil {
+		return models.StandingsRules{}, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.StandingsRules{}, fmt.Errorf("begin transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM standings_tiebreakers WHERE competition_id = $1`,
+		rules.CompetitionID); err != nil {
+		return models.StandingsRules{}, fmt.Errorf("clear standings tiebreakers: %s", err)
+	}
+
+	query := `
+		INSERT INTO standings_tiebreakers (competition_id, priority, tiebreaker)
+		VALUES ($1, $2, $3)`
+	for i, tiebreaker := range rules.Tiebreakers {
+		if _, err := tx.ExecContext(ctx, query, rules.CompetitionID, i, tiebreaker); err != nil {
+			return models.StandingsRules{}, fmt.Errorf("save standings tiebreaker: %s", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.StandingsRules{}, fmt.Errorf("commit transaction: %s", err)
+	}
+
+	savedRules, err := s.GetStandingsRules(ctx, rules.CompetitionID)
+	if err != nil {
+		return models.StandingsRules{}, fmt.Errorf("get standings rules: %s", err)
+	}
+
+	return savedRules, nil
+}
+
+// fairPlayPoints totals the card deductions of each team over the finished
+// matches of a competition.
+func (s *standingsService) fairPlayPoints(ctx context.Context, competitionID int64, seasonID *int64) (map[int64]int, error) {
+	var conds conditions
+	conds.add("m.competition_id = ?", competitionID)
+	conds.add("m.status = ?", models.MatchFinished)
+	if seasonID != nil {
+		conds.add("m.season_id = ?", *seasonID)
+	}
+	conds.add("e.type IN (?, ?, ?)", models.EventYellowCard, models.EventSecondYellow, models.EventRedCard)
+
+	query := `
+		SELECT
+			e.team_id
+			, e.type
+		FROM match_events e
+		JOIN matches m ON m.id = e.match_id` + conds.where()
+
+	var cards []models.MatchEvent
+	if err := s.db.SelectContext(ctx, &cards, s.db.Rebind(query), conds.args...); err != nil {
+		return nil, fmt.Errorf("get the list of cards: %s", err)
+	}
+
+	points := make(map[int64]int)
+	for _, card := range cards {
+		points[card.TeamID] -= fairPlayDeductions[card.Type]
+	}
+
+	return points, nil
+}
+
+// tiebreaks holds the ordered tiebreakers of a competition along with the
+// fair-play points of its teams and the seed its lots are drawn with.
+type tiebreaks struct {
+	order    []string
+	fairPlay map[int64]int
+	seed     string
+}
+
+// computeStandings builds the league table of the given teams from the
+// finished matches, ordered by points, then by the tiebreakers in turn and
+// finally by team name. The matches are expected in kickoff order for the
+// form of each team to come out right.
+func computeStandings(teams []models.Team, matches []models.Match, points PointsSystem, ties tiebreaks) []models.Standing {
+	rows := make(map[int64]*models.Standing, len(teams))
+	results := make(map[int64][]string, len(teams))
+	awayGoals := make(map[int64]int, len(teams))
+	standings := make([]models.Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = models.Standing{TeamID: team.ID, TeamName: team.Name}
+		rows[team.ID] = &standings[i]
+	}
+
+	for _, match := range matches {
+		if match.Status != models.MatchFinished {
+			continue
+		}
+		for _, teamID := range []int64{match.HomeTeamID, match.AwayTeamID} {
+			row, ok := rows[teamID]
+			if !ok {
+				continue
+			}
+
+			results[teamID] = append(results[teamID], addResult(row, match, points))
+			row.Played++
+			if teamID == match.AwayTeamID {
+				awayGoals[teamID] += match.AwayScore
+			}
+		}
+	}
+
+	for i := range standings {
+		standings[i].GoalDifference = standings[i].GoalsFor - standings[i].GoalsAgainst
+		standings[i].Form = recentForm(results[standings[i].TeamID])
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].TeamName < standings[j].TeamName
+	})
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Points > standings[j].Points
+	})
+
+	table := tableOrder{matches: matches, points: points, awayGoals: awayGoals, ties: ties}
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && standings[end].Points == standings[start].Points {
+			end++
+		}
+		table.rank(standings[start:end], ties.order)
+		start = end
+	}
+
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+
+	return standings
+}
+
+// addResult counts a finished match in a team's row, leaving the played
+// matches and goal difference to the caller, and returns its result.
+func addResult(row *models.Standing, match models.Match, points PointsSystem) string {
+	scored, conceded := match.ScoreFor(row.TeamID)
+	row.GoalsFor += scored
+	row.GoalsAgainst += conceded
+
+	result := match.ResultFor(row.TeamID)
+	switch result {
+	case models.ResultWin:
+		row.Won++
+		row.Points += points.Win
+	case models.ResultDraw:
+		row.Drawn++
+		row.Points += points.Draw
+	case models.ResultLoss:
+		row.Lost++
+		row.Points += points.Loss
+	}
+
+	return result
+}
+
+// tableOrder ranks the teams level on points in a league table.
+type tableOrder struct {
+	matches   []models.Match
+	points    PointsSystem
+	awayGoals map[int64]int
+	ties      tiebreaks
+}
+
+// rank orders in place rows level on everything before the given
+// tiebreakers: the first one splits them and the rest are applied to each
+// group it leaves level. A run of head-to-head tiebreakers is applied as a
+// whole, see rankHeadToHead.
+func (t tableOrder) rank(rows []models.Standing, tiebreakers []string) {
+	if len(rows) < 2 || len(tiebreakers) == 0 {
+		return
+	}
+
+	if n := headToHeadRun(tiebreakers); n > 0 {
+		t.rankHeadToHead(rows, tiebreakers[:n], tiebreakers[n:])
+		return
+	}
+
+	values := t.values(tiebreakers[0], rows)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return values[rows[i].TeamID] > values[rows[j].TeamID]
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && values[rows[end].TeamID] == values[rows[start].TeamID] {
+			end++
+		}
+		t.rank(rows[start:end], tiebreakers[1:])
+		start = end
+	}
+}
+
+// rankHeadToHead orders in place rows by the given head-to-head tiebreakers
+// of the mini-league between them. Teams those leave level are ranked
+// again on the mini-league of their own matches, as long as that narrows
+// the group down, and only then by the rest of the tiebreakers.
+func (t tableOrder) rankHeadToHead(rows []models.Standing, headToHead, rest []string) {
+	values := make([]map[int64]int, len(headToHead))
+	for i, tiebreaker := range headToHead {
+		values[i] = t.values(tiebreaker, rows)
+	}
+	compare := func(a, b int64) int {
+		for _, value := range values {
+			if value[a] != value[b] {
+				return value[a] - value[b]
+			}
+		}
+		return 0
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compare(rows[i].TeamID, rows[j].TeamID) > 0
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && compare(rows[end].TeamID, rows[start].TeamID) == 0 {
+			end++
+		}
+		switch {
+		case end-start < 2:
+		case end-start < len(rows):
+			t.rankHeadToHead(rows[start:end], headToHead, rest)
+		default:
+			t.rank(rows[start:end], rest)
+		}
+		start = end
+	}
+}
+
+// headToHeadRun returns how many of the leading tiebreakers are head-to-head
+// ones.
+func headToHeadRun(tiebreakers []string) int {
+	for i, tiebreaker := range tiebreakers {
+		switch tiebreaker {
+		case models.TiebreakerHeadToHeadPoints, models.TiebreakerHeadToHeadGoalDifference,
+			models.TiebreakerHeadToHeadGoalsScored:
+		default:
+			return i
+		}
+	}
+	return len(tiebreakers)
+}
+
+// values returns what a tiebreaker compares teams on, higher being better.
+func (t tableOrder) values(tiebreaker string, rows []models.Standing) map[int64]int {
+	values := make(map[int64]int, len(rows))
+
+	var league map[int64]*models.Standing
+	switch tiebreaker {
+	case models.TiebreakerHeadToHeadPoints, models.TiebreakerHeadToHeadGoalDifference,
+		models.TiebreakerHeadToHeadGoalsScored:
+		league = t.miniLeague(rows)
+	}
+
+	for _, row := range rows {
+		var value int
+		switch tiebreaker {
+		case models.TiebreakerGoalDifference:
+			value = row.GoalDifference
+		case models.TiebreakerGoalsScored:
+			value = row.GoalsFor
+		case models.TiebreakerHeadToHeadPoints:
+			value = league[row.TeamID].Points
+		case models.TiebreakerHeadToHeadGoalDifference:
+			value = league[row.TeamID].GoalsFor - league[row.TeamID].GoalsAgainst
+		case models.TiebreakerHeadToHeadGoalsScored:
+			value = league[row.TeamID].GoalsFor
+		case models.TiebreakerAwayGoals:
+			value = t.awayGoals[row.TeamID]
+		case models.TiebreakerFairPlay:
+			value = t.ties.fairPlay[row.TeamID]
+		case models.TiebreakerDrawingOfLots:
+			value = drawLot(t.ties.seed, row.TeamID)
+		}
+		values[row.TeamID] = value
+	}
+
+	return values
+}
+
+// miniLeague builds the rows of a table counting only the finished matches
+// played between the given teams.
+func (t tableOrder) miniLeague(rows []models.Standing) map[int64]*models.Standing {
+	league := make(map[int64]*models.Standing, len(rows))
+	for _, row := range rows {
+		league[row.TeamID] = &models.Standing{TeamID: row.TeamID}
+	}
+
+	for _, match := range t.matches {
+		home, away := league[match.HomeTeamID], league[match.AwayTeamID]
+		if match.Status != models.MatchFinished || home == nil || away == nil {
+			continue
+		}
+		addResult(home, match, t.points)
+		addResult(away, match, t.points)
+	}
+
+	return league
+}
+
+// drawLot draws a team's lot from the seed of its table, so that the same
+// table always comes out of the same draw.
+func drawLot(seed string, teamID int64) int {
+	hash := fnv.New32a()
+	fmt.Fprintf(hash, "%s:%d", seed, teamID)
+	return int(hash.Sum32() >> 1)
+}
+
+// validateStandingsRules checks the tiebreakers are known and set once.
+func validateStandingsRules(rules models.StandingsRules) error {
+	var problems []string
+
+	if len(rules.Tiebreakers) == 0 {
+		problems = append(problems, "at least one tiebreaker is required")
+	}
+
+	seen := make(map[string]bool, len(rules.Tiebreakers))
+	for _, tiebreaker := range rules.Tiebreakers {
+		known := false
+		for _, supported := range tiebreakers {
+			known = known || tiebreaker == supported
+		}
+		switch {
+		case !known:
+			problems = append(problems, fmt.Sprintf("unknown tiebreaker %q", tiebreaker))
+		case seen[tiebreaker]:
+			problems = append(problems, fmt.Sprintf("tiebreaker %q is set more than once", tiebreaker))
+		}
+		seen[tiebreaker] = true
+	}
+
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+
+	return nil
+}
+
+// recentForm joins the latest results of a team, most recent first, out of
+// all its results in kickoff order.
+func recentForm(results []string) string {
+	var form strings.Builder
+	for i := len(results) - 1; i >= 0 && i >= len(results)-formLength; i-- {
+		form.WriteString(results[i])
+	}
+	return form.String()
+}
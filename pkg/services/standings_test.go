@@ -0,0 +1,216 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func finishedMatch(home, away int64, homeScore, awayScore int) models.Match {
+	return models.Match{
+		HomeTeamID: home,
+		AwayTeamID: away,
+		Status:     models.MatchFinished,
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+	}
+}
+
+func TestComputeStandings(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 2, 2, 0),
+		finishedMatch(2, 3, 1, 1),
+		finishedMatch(3, 1, 3, 1),
+		{HomeTeamID: 1, AwayTeamID: 3, Status: models.MatchScheduled, HomeScore: 5},
+	}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 3, Draw: 1}, tiebreaks{order: defaultTiebreakers})
+
+	assert.Equal(t, []models.Standing{
+		{Position: 1, TeamID: 3, TeamName: "Charlie", Played: 2, Won: 1, Drawn: 1, GoalsFor: 4, GoalsAgainst: 2, GoalDifference: 2, Points: 4, Form: "WD"},
+		{Position: 2, TeamID: 1, TeamName: "Alpha", Played: 2, Won: 1, Lost: 1, GoalsFor: 3, GoalsAgainst: 3, GoalDifference: 0, Points: 3, Form: "LW"},
+		{Position: 3, TeamID: 2, TeamName: "Bravo", Played: 2, Drawn: 1, Lost: 1, GoalsFor: 1, GoalsAgainst: 3, GoalDifference: -2, Points: 1, Form: "DL"},
+	}, standings)
+}
+
+func TestComputeStandings_pointsSystem(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 2, 1, 0),
+		finishedMatch(2, 1, 0, 0),
+	}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 2, Draw: 1, Loss: 1}, tiebreaks{order: defaultTiebreakers})
+
+	assert.Equal(t, 3, standings[0].Points)
+	assert.Equal(t, 2, standings[1].Points)
+}
+
+func TestComputeStandings_tieBreaks(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Delta"},
+		{ID: 2, Name: "Charlie"},
+		{ID: 3, Name: "Bravo"},
+		{ID: 4, Name: "Alpha"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 4, 3, 0),
+		finishedMatch(2, 4, 4, 1),
+		finishedMatch(3, 4, 1, 0),
+	}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 3, Draw: 1}, tiebreaks{order: defaultTiebreakers})
+
+	var order []int64
+	for _, standing := range standings {
+		order = append(order, standing.TeamID)
+	}
+	// Delta and Charlie are level on goal difference; Charlie scored more.
+	assert.Equal(t, []int64{2, 1, 3, 4}, order)
+}
+
+func TestComputeStandings_headToHead(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+		{ID: 4, Name: "Delta"},
+		{ID: 5, Name: "Echo"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 2, 1, 0),
+		finishedMatch(1, 3, 3, 0),
+		finishedMatch(2, 3, 1, 1),
+		finishedMatch(2, 4, 1, 0),
+		finishedMatch(2, 5, 0, 0),
+		finishedMatch(3, 4, 5, 0),
+		finishedMatch(3, 5, 0, 0),
+	}
+	ties := tiebreaks{order: []string{
+		models.TiebreakerHeadToHeadPoints,
+		models.TiebreakerHeadToHeadGoalDifference,
+		models.TiebreakerGoalsScored,
+	}}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 2, Draw: 1}, ties)
+
+	var order []int64
+	for _, standing := range standings {
+		order = append(order, standing.TeamID)
+	}
+	// Alpha, Bravo and Charlie are level on points. Alpha tops their
+	// mini-league, where Bravo lost to Alpha by fewer goals than Charlie.
+	assert.Equal(t, []int64{1, 2, 3, 5, 4}, order)
+}
+
+func TestComputeStandings_headToHeadReapplied(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+		{ID: 4, Name: "Delta"},
+		{ID: 5, Name: "Echo"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 2, 1, 0),
+		finishedMatch(1, 3, 1, 0),
+		finishedMatch(2, 3, 1, 0),
+		finishedMatch(3, 4, 1, 0),
+		finishedMatch(2, 5, 1, 0),
+		finishedMatch(3, 5, 5, 0),
+		finishedMatch(4, 5, 1, 0),
+		finishedMatch(5, 4, 0, 1),
+	}
+	ties := tiebreaks{order: []string{
+		models.TiebreakerHeadToHeadPoints,
+		models.TiebreakerGoalDifference,
+	}}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 3, Draw: 1}, ties)
+
+	var order []int64
+	for _, standing := range standings {
+		order = append(order, standing.TeamID)
+	}
+	// Alpha, Bravo, Charlie and Delta are level on points. Their mini-league
+	// leaves Bravo and Charlie level, and the mini-league of their own match
+	// puts Bravo ahead despite Charlie's better goal difference.
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, order)
+}
+
+func TestComputeStandings_awayGoalsAndFairPlay(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+	}
+	matches := []models.Match{
+		finishedMatch(1, 3, 3, 0),
+		finishedMatch(3, 2, 0, 3),
+	}
+
+	standings := computeStandings(teams, matches, PointsSystem{Win: 3, Draw: 1},
+		tiebreaks{order: []string{models.TiebreakerGoalDifference, models.TiebreakerAwayGoals}})
+	assert.Equal(t, int64(2), standings[0].TeamID)
+
+	standings = computeStandings(teams, matches, PointsSystem{Win: 3, Draw: 1},
+		tiebreaks{order: []string{models.TiebreakerFairPlay}, fairPlay: map[int64]int{1: -1, 2: -3}})
+	assert.Equal(t, int64(1), standings[0].TeamID)
+}
+
+func TestComputeStandings_drawingOfLots(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+		{ID: 4, Name: "Delta"},
+	}
+	reversed := []models.Team{teams[3], teams[2], teams[1], teams[0]}
+	ties := tiebreaks{order: []string{models.TiebreakerDrawingOfLots}, seed: "1:2"}
+
+	standings := computeStandings(teams, nil, PointsSystem{Win: 3, Draw: 1}, ties)
+	again := computeStandings(reversed, nil, PointsSystem{Win: 3, Draw: 1}, ties)
+
+	assert.Equal(t, standings, again)
+}
+
+func TestValidateStandingsRules(t *testing.T) {
+	assert.NoError(t, validateStandingsRules(models.StandingsRules{Tiebreakers: []string{
+		models.TiebreakerHeadToHeadPoints, models.TiebreakerGoalDifference, models.TiebreakerDrawingOfLots,
+	}}))
+	assert.Error(t, validateStandingsRules(models.StandingsRules{}))
+	assert.Error(t, validateStandingsRules(models.StandingsRules{Tiebreakers: []string{"coin_toss"}}))
+	assert.Error(t, validateStandingsRules(models.StandingsRules{Tiebreakers: []string{
+		models.TiebreakerGoalDifference, models.TiebreakerGoalDifference,
+	}}))
+}
+
+func TestComputeStandings_teamsWithoutMatches(t *testing.T) {
+	teams := []models.Team{
+		{ID: 2, Name: "Bravo"},
+		{ID: 1, Name: "Alpha"},
+	}
+
+	standings := computeStandings(teams, nil, PointsSystem{Win: 3, Draw: 1}, tiebreaks{order: defaultTiebreakers})
+
+	assert.Equal(t, []models.Standing{
+		{Position: 1, TeamID: 1, TeamName: "Alpha"},
+		{Position: 2, TeamID: 2, TeamName: "Bravo"},
+	}, standings)
+}
+
+func TestRecentForm(t *testing.T) {
+	assert.Equal(t, "", recentForm(nil))
+	assert.Equal(t, "DW", recentForm([]string{models.ResultWin, models.ResultDraw}))
+	assert.Equal(t, "WWDLL", recentForm([]string{"W", "W", "L", "L", "D", "W", "W"}))
+}
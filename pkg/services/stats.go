@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// matchMinutes is the regular length of a match.
+const matchMinutes = 90
+
+// StatsService service interface.
+type StatsService interface {
+	GetPlayerStats(ctx context.Context, playerID int64, filter models.StatsFilter) (models.PlayerStats, error)
+	GetTeamStats(ctx context.Context, teamID int64, filter models.StatsFilter) (models.TeamStats, error)
+	GetHeadToHead(ctx context.Context, teamID, opponentID int64, filter models.HeadToHeadFilter) (models.HeadToHead, error)
+	GetTeamForm(ctx context.Context, teamID int64, filter models.FormFilter) (models.Form, error)
+	GetLeaderboard(ctx context.Context, seasonID int64, category string, page models.Pagination) (models.Leaderboard, error)
+}
+
+type statsService struct {
+	db *sqlx.DB
+}
+
+// NewStatsService returns an initialized StatsService implementation.
+func NewStatsService(db *sqlx.DB) StatsService {
+	return &statsService{db: db}
+}
+
+func (s *statsService) GetPlayerStats(ctx context.Context, playerID int64, filter models.StatsFilter) (models.PlayerStats, error) {
+	var conds conditions
+	conds.add("(e.player_id = ? OR e.related_player_id = ?)", playerID, playerID)
+
+	events, err := s.listEvents(ctx, conds, filter)
+	if err != nil {
+		return models.PlayerStats{}, err
+	}
+
+	conds = conditions{}
+	conds.add("lp.player_id = ?", playerID)
+
+	lineups, err := s.listLineupEntries(ctx, conds, filter)
+	if err != nil {
+		return models.PlayerStats{}, err
+	}
+
+	return aggregatePlayerStats(playerID, events, lineups), nil
+}
+
+func (s *statsService) GetTeamStats(ctx context.Context, teamID int64, filter models.StatsFilter) (models.TeamStats, error) {
+	var conds conditions
+	conds.add("(m.home_team_id = ? OR m.away_team_id = ?)", teamID, teamID)
+	conds.add("m.status IN (?, ?)", models.MatchLive, models.MatchFinished)
+	if filter.SeasonID != nil {
+		conds.add("m.season_id = ?", *filter.SeasonID)
+	}
+
+	var matches int
+	query := `SELECT COUNT(*) FROM matches m` + conds.where()
+	if err := s.db.GetContext(ctx, &matches, s.db.Rebind(query), conds.args...); err != nil {
+		return models.TeamStats{}, fmt.Errorf("count team matches: %s", err)
+	}
+
+	conds = conditions{}
+	conds.add("e.team_id = ?", teamID)
+
+	events, err := s.listEvents(ctx, conds, filter)
+	if err != nil {
+		return models.TeamStats{}, err
+	}
+
+	conds = conditions{}
+	conds.add("lp.team_id = ?", teamID)
+
+	lineups, err := s.listLineupEntries(ctx, conds, filter)
+	if err != nil {
+		return models.TeamStats{}, err
+	}
+
+	stats := aggregateTeamStats(teamID, events, lineups)
+	stats.Matches = matches
+
+	return stats, nil
+}
+
+func (s *statsService) GetHeadToHead(ctx context.Context, teamID, opponentID int64, filter models.HeadToHeadFilter) (models.HeadToHead, error) {
+	var conds conditions
+	conds.add("((home_team_id = ? AND away_team_id = ?) OR (home_team_id = ? AND away_team_id = ?))",
+		teamID, opponentID, opponentID, teamID)
+	conds.add("status = ?", models.MatchFinished)
+	if filter.CompetitionID != nil {
+		conds.add("competition_id = ?", *filter.CompetitionID)
+	}
+	if filter.From != nil {
+		conds.add("kickoff >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		conds.add("kickoff < ?", filter.To.AddDate(0, 0, 1))
+	}
+
+	query := `
+		SELECT
+			id
+			, competition_id
+			, season_id
+			, home_team_id
+			, away_team_id
+			, kickoff
+			, venue_id
+			, status
+			, home_score
+			, away_score
+			, created_at
+			, updated_at
+		FROM matches` + conds.where() + `
+		ORDER BY kickoff`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, s.db.Rebind(query), conds.args...); err != nil {
+		return models.HeadToHead{}, fmt.Errorf("get the list of meetings: %s", err)
+	}
+
+	return headToHead(teamID, opponentID, matches), nil
+}
+
+func (s *statsService) GetTeamForm(ctx context.Context, teamID int64, filter models.FormFilter) (models.Form, error) {
+	var conds conditions
+	switch filter.Side {
+	case models.SideHome:
+		conds.add("m.home_team_id = ?", teamID)
+	case models.SideAway:
+		conds.add("m.away_team_id = ?", teamID)
+	default:
+		conds.add("(m.home_team_id = ? OR m.away_team_id = ?)", teamID, teamID)
+	}
+	conds.add("m.status = ?", models.MatchFinished)
+
+	last := filter.Last
+	if last <= 0 {
+		last = formLength
+	}
+
+	query := `
+		SELECT
+			m.id AS match_id
+			, m.kickoff
+			, CASE WHEN m.home_team_id = ? THEN 'home' ELSE 'away' END AS side
+			, o.id AS opponent_id
+			, o.name AS opponent_name
+			, CASE WHEN m.home_team_id = ? THEN m.home_score ELSE m.away_score END AS goals_for
+			, CASE WHEN m.home_team_id = ? THEN m.away_score ELSE m.home_score END AS goals_against
+		FROM matches m
+		JOIN teams o ON o.id = CASE WHEN m.home_team_id = ? THEN m.away_team_id ELSE m.home_team_id END` + conds.where() + `
+		ORDER BY m.kickoff DESC
+		LIMIT ?`
+	args := append([]interface{}{teamID, teamID, teamID, teamID}, conds.args...)
+	args = append(args, last)
+
+	var results []models.FormResult
+	if err := s.db.SelectContext(ctx, &results, s.db.Rebind(query), args...); err != nil {
+		return models.Form{}, fmt.Errorf("get the latest results: %s", err)
+	}
+
+	form := models.Form{TeamID: teamID, Results: []models.FormResult{}}
+	for _, result := range results {
+		result.Result = models.Result(result.GoalsFor, result.GoalsAgainst)
+		form.Form += result.Result
+		form.Results = append(form.Results, result)
+	}
+
+	return form, nil
+}
+
+// listEvents returns the events matching the given conditions recorded in
+// matches that have kicked off.
+func (s *statsService) listEvents(ctx context.Context, conds conditions, filter models.StatsFilter) ([]models.MatchEvent, error) {
+	conds.add("m.status IN (?, ?)", models.MatchLive, models.MatchFinished)
+	if filter.SeasonID != nil {
+		conds.add("m.season_id = ?", *filter.SeasonID)
+	}
+
+	query := `
+		SELECT
+			e.id
+			, e.match_id
+			, e.team_id
+			, e.player_id
+			, e.related_player_id
+			, e.type
+			, e.minute
+			, e.added_time
+		FROM match_events e
+		JOIN matches m ON m.id = e.match_id` + conds.where() + `
+		ORDER BY e.match_id, e.minute, e.added_time, e.id`
+
+	var events []models.MatchEvent
+	if err := s.db.SelectContext(ctx, &events, s.db.Rebind(query), conds.args...); err != nil {
+		return nil, fmt.Errorf("get the list of match events: %s", err)
+	}
+
+	return events, nil
+}
+
+// listLineupEntries returns the lineup entries matching the given
+// conditions in matches that have kicked off.
+func (s *statsService) listLineupEntries(ctx context.Context, conds conditions, filter models.StatsFilter) ([]lineupEntry, error) {
+	conds.add("m.status IN (?, ?)", models.MatchLive, models.MatchFinished)
+	if filter.SeasonID != nil {
+		conds.add("m.season_id = ?", *filter.SeasonID)
+	}
+
+	query := `
+		SELECT
+			lp.match_id
+			, lp.team_id
+			, lp.player_id
+			, lp.starter
+		FROM lineup_players lp
+		JOIN matches m ON m.id = lp.match_id` + conds.where() + `
+		ORDER BY lp.match_id, lp.sort_order`
+
+	var entries []lineupEntry
+	if err := s.db.SelectContext(ctx, &entries, s.db.Rebind(query), conds.args...); err != nil {
+		return nil, fmt.Errorf("get the list of lineup players: %s", err)
+	}
+
+	return entries, nil
+}
+
+// spell is the time a player spent on the pitch in a match.
+type spell struct {
+	appeared bool
+	on, off  int
+}
+
+// aggregatePlayerStats builds the statistics of a player from the events
+// and lineups of the matches they took part in, see playerSpells for how
+// appearances and minutes are counted.
+func aggregatePlayerStats(playerID int64, events []models.MatchEvent, lineups []lineupEntry) models.PlayerStats {
+	stats := models.PlayerStats{PlayerID: playerID}
+
+	for _, event := range events {
+		isPlayer := event.PlayerID == playerID
+		isRelated := event.RelatedPlayerID != nil && *event.RelatedPlayerID == playerID
+		if !isPlayer && !isRelated {
+			continue
+		}
+
+		switch event.Type {
+		case models.EventGoal:
+			if isPlayer {
+				stats.Goals++
+			} else {
+				stats.Assists++
+			}
+		case models.EventYellowCard:
+			stats.YellowCards++
+		case models.EventSecondYellow, models.EventRedCard:
+			stats.RedCards++
+		}
+	}
+
+	for _, played := range playerSpells(playerID, events, lineups) {
+		if played.appeared {
+			stats.Appearances++
+			stats.Minutes += played.off - played.on
+		}
+	}
+
+	return stats
+}
+
+// playerSpells returns the time a player spent on the pitch in each match,
+// keyed by match. A player listed in a lineup appears when they start or
+// come off the bench. In matches without a lineup, a player appears when
+// they are involved in one of its events, and is assumed to start unless
+// brought on as a substitute. Either way they play until the final
+// whistle unless substituted or sent off.
+func playerSpells(playerID int64, events []models.MatchEvent, lineups []lineupEntry) map[int64]*spell {
+	spells := make(map[int64]*spell)
+	for _, entry := range lineups {
+		if entry.PlayerID == playerID {
+			spells[entry.MatchID] = &spell{appeared: entry.Starter, off: matchMinutes}
+		}
+	}
+
+	for _, event := range events {
+		isPlayer := event.PlayerID == playerID
+		isRelated := event.RelatedPlayerID != nil && *event.RelatedPlayerID == playerID
+		if !isPlayer && !isRelated {
+			continue
+		}
+
+		played, ok := spells[event.MatchID]
+		if !ok {
+			played = &spell{appeared: true, off: matchMinutes}
+			spells[event.MatchID] = played
+		}
+
+		switch event.Type {
+		case models.EventSecondYellow, models.EventRedCard:
+			played.off = event.Minute
+		case models.EventSubstitution:
+			if isPlayer {
+				played.appeared = true
+				played.on = event.Minute
+			} else {
+				played.off = event.Minute
+			}
+		}
+	}
+
+	return spells
+}
+
+// aggregateTeamStats builds the statistics of a team and of each of its
+// players from the events and lineups recorded for the team.
+func aggregateTeamStats(teamID int64, events []models.MatchEvent, lineups []lineupEntry) models.TeamStats {
+	stats := models.TeamStats{TeamID: teamID, Players: []models.PlayerStats{}}
+
+	var playerIDs []int64
+	seen := make(map[int64]bool)
+	addPlayer := func(id int64) {
+		if !seen[id] {
+			seen[id] = true
+			playerIDs = append(playerIDs, id)
+		}
+	}
+	for _, event := range events {
+		addPlayer(event.PlayerID)
+		if event.RelatedPlayerID != nil {
+			addPlayer(*event.RelatedPlayerID)
+		}
+	}
+	for _, entry := range lineups {
+		addPlayer(entry.PlayerID)
+	}
+	sort.Slice(playerIDs, func(i, j int) bool { return playerIDs[i] < playerIDs[j] })
+
+	for _, playerID := range playerIDs {
+		player := aggregatePlayerStats(playerID, events, lineups)
+		stats.Goals += player.Goals
+		stats.Assists += player.Assists
+		stats.YellowCards += player.YellowCards
+		stats.RedCards += player.RedCards
+		stats.Players = append(stats.Players, player)
+	}
+
+	return stats
+}
+
+// headToHead sums up the meetings between two teams. The biggest win is
+// the one by the widest margin, then with the most goals scored, the
+// earliest meeting winning any remaining tie.
+func headToHead(teamID, opponentID int64, matches []models.Match) models.HeadToHead {
+	h2h := models.HeadToHead{
+		TeamID:     teamID,
+		OpponentID: opponentID,
+		Matches:    []models.Match{},
+	}
+
+	bigger := func(match models.Match, than *models.Match, winnerID int64) bool {
+		if than == nil {
+			return true
+		}
+		scored, conceded := match.ScoreFor(winnerID)
+		bestScored, bestConceded := than.ScoreFor(winnerID)
+		if scored-conceded != bestScored-bestConceded {
+			return scored-conceded > bestScored-bestConceded
+		}
+		return scored > bestScored
+	}
+
+	for i := range matches {
+		match := matches[i]
+		scored, conceded := match.ScoreFor(teamID)
+
+		h2h.Played++
+		h2h.GoalsFor += scored
+		h2h.GoalsAgainst += conceded
+		switch match.ResultFor(teamID) {
+		case models.ResultWin:
+			h2h.Won++
+			if bigger(match, h2h.BiggestWin, teamID) {
+				h2h.BiggestWin = &matches[i]
+			}
+		case models.ResultLoss:
+			h2h.Lost++
+			if bigger(match, h2h.OpponentBiggestWin, opponentID) {
+				h2h.OpponentBiggestWin = &matches[i]
+			}
+		default:
+			h2h.Drawn++
+		}
+		h2h.Matches = append(h2h.Matches, match)
+	}
+
+	return h2h
+}
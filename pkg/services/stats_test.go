@@ -0,0 +1,116 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func int64Ptr(n int64) *int64 {
+	return &n
+}
+
+func TestAggregatePlayerStats(t *testing.T) {
+	events := []models.MatchEvent{
+		{MatchID: 1, TeamID: 1, PlayerID: 10, RelatedPlayerID: int64Ptr(11), Type: models.EventGoal, Minute: 12},
+		{MatchID: 1, TeamID: 1, PlayerID: 11, Type: models.EventGoal, Minute: 30},
+		{MatchID: 1, TeamID: 1, PlayerID: 10, Type: models.EventYellowCard, Minute: 40},
+		{MatchID: 1, TeamID: 1, PlayerID: 12, RelatedPlayerID: int64Ptr(10), Type: models.EventSubstitution, Minute: 70},
+		{MatchID: 2, TeamID: 1, PlayerID: 10, Type: models.EventOwnGoal, Minute: 5},
+		{MatchID: 2, TeamID: 1, PlayerID: 10, Type: models.EventRedCard, Minute: 60},
+		{MatchID: 3, TeamID: 1, PlayerID: 11, RelatedPlayerID: int64Ptr(10), Type: models.EventGoal, Minute: 88},
+	}
+
+	assert.Equal(t, models.PlayerStats{
+		PlayerID:    10,
+		Appearances: 3,
+		Minutes:     70 + 60 + 90,
+		Goals:       1,
+		Assists:     1,
+		YellowCards: 1,
+		RedCards:    1,
+	}, aggregatePlayerStats(10, events, nil))
+
+	assert.Equal(t, models.PlayerStats{
+		PlayerID:    12,
+		Appearances: 1,
+		Minutes:     20,
+	}, aggregatePlayerStats(12, events, nil))
+
+	assert.Equal(t, models.PlayerStats{PlayerID: 99}, aggregatePlayerStats(99, events, nil))
+}
+
+func TestAggregatePlayerStatsFromLineups(t *testing.T) {
+	lineups := []lineupEntry{
+		{MatchID: 1, TeamID: 1, PlayerID: 10, Starter: true},
+		{MatchID: 1, TeamID: 1, PlayerID: 12},
+		{MatchID: 2, TeamID: 1, PlayerID: 10, Starter: true},
+		{MatchID: 2, TeamID: 1, PlayerID: 12},
+		{MatchID: 3, TeamID: 1, PlayerID: 12},
+	}
+	events := []models.MatchEvent{
+		{MatchID: 1, TeamID: 1, PlayerID: 12, RelatedPlayerID: int64Ptr(10), Type: models.EventSubstitution, Minute: 60},
+		{MatchID: 1, TeamID: 1, PlayerID: 12, Type: models.EventRedCard, Minute: 85},
+		{MatchID: 3, TeamID: 1, PlayerID: 12, Type: models.EventYellowCard, Minute: 45},
+	}
+
+	assert.Equal(t, models.PlayerStats{
+		PlayerID:    10,
+		Appearances: 2,
+		Minutes:     60 + 90,
+	}, aggregatePlayerStats(10, events, lineups))
+
+	assert.Equal(t, models.PlayerStats{
+		PlayerID:    12,
+		Appearances: 1,
+		Minutes:     25,
+		YellowCards: 1,
+		RedCards:    1,
+	}, aggregatePlayerStats(12, events, lineups))
+}
+
+func TestAggregateTeamStats(t *testing.T) {
+	events := []models.MatchEvent{
+		{MatchID: 1, TeamID: 1, PlayerID: 11, RelatedPlayerID: int64Ptr(10), Type: models.EventGoal, Minute: 12},
+		{MatchID: 1, TeamID: 1, PlayerID: 10, Type: models.EventSecondYellow, Minute: 80},
+		{MatchID: 2, TeamID: 1, PlayerID: 11, Type: models.EventOwnGoal, Minute: 3},
+	}
+
+	stats := aggregateTeamStats(1, events, nil)
+
+	assert.Equal(t, int64(1), stats.TeamID)
+	assert.Equal(t, 1, stats.Goals)
+	assert.Equal(t, 1, stats.Assists)
+	assert.Equal(t, 0, stats.YellowCards)
+	assert.Equal(t, 1, stats.RedCards)
+	if assert.Len(t, stats.Players, 2) {
+		assert.Equal(t, int64(10), stats.Players[0].PlayerID)
+		assert.Equal(t, 80, stats.Players[0].Minutes)
+		assert.Equal(t, int64(11), stats.Players[1].PlayerID)
+		assert.Equal(t, 2, stats.Players[1].Appearances)
+	}
+}
+
+func TestHeadToHead(t *testing.T) {
+	matches := []models.Match{
+		finishedMatch(1, 2, 2, 0),
+		finishedMatch(2, 1, 1, 1),
+		finishedMatch(2, 1, 3, 0),
+		finishedMatch(1, 2, 4, 2),
+		finishedMatch(2, 1, 0, 1),
+	}
+
+	h2h := headToHead(1, 2, matches)
+
+	assert.Equal(t, 5, h2h.Played)
+	assert.Equal(t, 3, h2h.Won)
+	assert.Equal(t, 1, h2h.Drawn)
+	assert.Equal(t, 1, h2h.Lost)
+	assert.Equal(t, 8, h2h.GoalsFor)
+	assert.Equal(t, 6, h2h.GoalsAgainst)
+	assert.Equal(t, &matches[3], h2h.BiggestWin)
+	assert.Equal(t, &matches[2], h2h.OpponentBiggestWin)
+	assert.Equal(t, matches, h2h.Matches)
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// defaultSuspensionRules apply to competitions without suspension rules.
+var defaultSuspensionRules = models.SuspensionRules{RedCardBan: 1, YellowCardLimit: 5, YellowCardBan: 1}
+
+// SuspensionsService service interface.
+type SuspensionsService interface {
+	GetSuspensionRules(ctx context.Context, competitionID int64) (models.SuspensionRules, error)
+	SaveSuspensionRules(ctx context.Context, rules models.SuspensionRules) (models.SuspensionRules, error)
+	ListPlayerSuspensions(ctx context.Context, playerID int64) ([]models.Suspension, error)
+	ListSuspendedPlayers(ctx context.Context, matchID, teamID int64) ([]int64, error)
+}
+
+type suspensionsService struct {
+	db *sqlx.DB
+}
+
+// NewSuspensionsService returns an initialized SuspensionsService implementation.
+func NewSuspensionsService(db *sqlx.DB) SuspensionsService {
+	return &suspensionsService{db: db}
+}
+
+func (s *suspensionsService) GetSuspensionRules(ctx context.Context, competitionID int64) (models.SuspensionRules, error) {
+	query := `
+		SELECT
+			competition_id
+			, red_card_ban
+			, yellow_card_limit
+			, yellow_card_ban
+		FROM suspension_rules
+		WHERE competition_id = $1`
+
+	var rules models.SuspensionRules
+	err := s.db.GetContext(ctx, &rules, query, competitionID)
+	if err == sql.ErrNoRows {
+		rules = defaultSuspensionRules
+		rules.CompetitionID = competitionID
+		return rules, nil
+	}
+	if err != nil {
+		return models.SuspensionRules{}, fmt.Errorf("get suspension rules: %s", err)
+	}
+
+	return rules, nil
+}
+
+func (s *suspensionsService) SaveSuspensionRules(ctx context.Context, rules models.SuspensionRules) (models.SuspensionRules, error) {
+	query := `
+		INSERT INTO suspension_rules (competition_id, red_card_ban, yellow_card_limit, yellow_card_ban)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (competition_id) DO UPDATE SET
+			red_card_ban = EXCLUDED.red_card_ban
+			, yellow_card_limit = EXCLUDED.yellow_card_limit
+			, yellow_card_ban = EXCLUDED.yellow_card_ban
+			, updated_at = CURRENT_TIMESTAMP`
+
+	if _, err := s.db.ExecContext(ctx, query, rules.CompetitionID, rules.RedCardBan, rules.YellowCardLimit,
+		rules.YellowCardBan); err != nil {
+		return models.SuspensionRules{}, fmt.Errorf("save suspension rules: %s", err)
+	}
+
+	savedRules, err := s.GetSuspensionRules(ctx, rules.CompetitionID)
+	if err != nil {
+		return models.SuspensionRules{}, fmt.Errorf("get suspension rules: %s", err)
+	}
+
+	return savedRules, nil
+}
+
+func (s *suspensionsService) ListPlayerSuspensions(ctx context.Context, playerID int64) ([]models.Suspension, error) {
+	query := `
+		SELECT DISTINCT
+			m.competition_id
+			, m.season_id
+			, e.team_id
+		FROM match_events e
+		JOIN matches m ON m.id = e.match_id
+		WHERE e.player_id = $1 AND e.type IN ($2, $3, $4) AND m.competition_id IS NOT NULL
+		ORDER BY m.competition_id, m.season_id, e.team_id`
+
+	var teams []struct {
+		CompetitionID int64  `db:"competition_id"`
+		SeasonID      *int64 `db:"season_id"`
+		TeamID        int64  `db:"team_id"`
+	}
+	if err := s.db.SelectContext(ctx, &teams, query, playerID,
+		models.EventYellowCard, models.EventSecondYellow, models.EventRedCard); err != nil {
+		return nil, fmt.Errorf("get the list of carded teams: %s", err)
+	}
+
+	suspensions := []models.Suspension{}
+	for _, team := range teams {
+		teamSuspensions, err := s.teamSuspensions(ctx, team.CompetitionID, team.SeasonID, team.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, suspension := range teamSuspensions {
+			if suspension.PlayerID == playerID {
+				suspensions = append(suspensions, suspension)
+			}
+		}
+	}
+
+	return suspensions, nil
+}
+
+func (s *suspensionsService) ListSuspendedPlayers(ctx context.Context, matchID, teamID int64) ([]int64, error) {
+	var match models.Match
+	if err := s.db.GetContext(ctx, &match, `SELECT competition_id, season_id FROM matches WHERE id = $1`, matchID); err != nil {
+		return nil, fmt.Errorf("get the match competition: %s", err)
+	}
+	if match.CompetitionID == nil {
+		return nil, nil
+	}
+
+	suspensions, err := s.teamSuspensions(ctx, *match.CompetitionID, match.SeasonID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var playerIDs []int64
+	for _, suspension := range suspensions {
+		for _, suspendedFor := range suspension.MatchIDs {
+			if suspendedFor == matchID {
+				playerIDs = append(playerIDs, suspension.PlayerID)
+			}
+		}
+	}
+
+	return playerIDs, nil
+}
+
+// teamSuspensions computes the suspensions of the players of a team in a
+// season of a competition. Cards do not carry over from one season to the
+// next; matches outside of any season count as a season of their own.
+func (s *suspensionsService) teamSuspensions(ctx context.Context, competitionID int64, seasonID *int64, teamID int64) ([]models.Suspension, error) {
+	rules, err := s.GetSuspensionRules(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	matchesQuery := `
+		SELECT
+			id
+			, kickoff
+			, status
+		FROM matches
+		WHERE competition_id = $1 AND season_id IS NOT DISTINCT FROM $2
+			AND (home_team_id = $3 OR away_team_id = $3) AND status NOT IN ($4, $5)
+		ORDER BY kickoff, id`
+
+	var matches []models.Match
+	if err := s.db.SelectContext(ctx, &matches, matchesQuery, competitionID, seasonID, teamID,
+		models.MatchPostponed, models.MatchCancelled); err != nil {
+		return nil, fmt.Errorf("get the list of team matches: %s", err)
+	}
+
+	cardsQuery := `
+		SELECT
+			e.id
+			, e.match_id
+			, e.team_id
+			, e.player_id
+			, e.type
+			, e.minute
+			, e.added_time
+		FROM match_events e
+		JOIN matches m ON m.id = e.match_id
+		WHERE m.competition_id = $1 AND m.season_id IS NOT DISTINCT FROM $2 AND e.team_id = $3
+			AND e.type IN ($4, $5, $6) AND m.status IN ($7, $8)`
+
+	var cards []models.MatchEvent
+	if err := s.db.SelectContext(ctx, &cards, cardsQuery, competitionID, seasonID, teamID,
+		models.EventYellowCard, models.EventSecondYellow, models.EventRedCard,
+		models.MatchLive, models.MatchFinished); err != nil {
+		return nil, fmt.Errorf("get the list of cards: %s", err)
+	}
+
+	suspensions := computeSuspensions(rules, teamID, cards, matches)
+	for i := range suspensions {
+		suspensions[i].SeasonID = seasonID
+	}
+
+	return suspensions, nil
+}
+
+// computeSuspensions works out the bans of the players of a team from the
+// cards they received in a competition. Matches are the team's matches in
+// the competition by kickoff; a ban covers the team's matches following
+// the one the card was shown in, and a player serves their bans one after
+// the other. The first caution of a match a player is sent off in for a
+// second yellow card is wiped out by the red card ban and does not count
+// towards the yellow card limit.
+func computeSuspensions(rules models.SuspensionRules, teamID int64, cards []models.MatchEvent, matches []models.Match) []models.Suspension {
+	order := make(map[int64]int, len(matches))
+	for i, match := range matches {
+		order[match.ID] = i
+	}
+
+	var sorted []models.MatchEvent
+	for _, card := range cards {
+		if _, ok := order[card.MatchID]; ok {
+			sorted = append(sorted, card)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if order[a.MatchID] != order[b.MatchID] {
+			return order[a.MatchID] < order[b.MatchID]
+		}
+		if a.Minute != b.Minute {
+			return a.Minute < b.Minute
+		}
+		if a.AddedTime != b.AddedTime {
+			return a.AddedTime < b.AddedTime
+		}
+		return a.ID < b.ID
+	})
+
+	type booking struct{ matchID, playerID int64 }
+	sentOff := make(map[booking]bool)
+	for _, card := range sorted {
+		if card.Type == models.EventSecondYellow {
+			sentOff[booking{card.MatchID, card.PlayerID}] = true
+		}
+	}
+
+	yellows := make(map[int64]int)
+	banEnd := make(map[int64]int)
+	suspensions := []models.Suspension{}
+	for _, card := range sorted {
+		var reason string
+		var length int
+		switch card.Type {
+		case models.EventSecondYellow, models.EventRedCard:
+			reason, length = models.SuspensionRedCard, rules.RedCardBan
+		case models.EventYellowCard:
+			if sentOff[booking{card.MatchID, card.PlayerID}] {
+				continue
+			}
+			yellows[card.PlayerID]++
+			if rules.YellowCardLimit == 0 || yellows[card.PlayerID]%rules.YellowCardLimit != 0 {
+				continue
+			}
+			reason, length = models.SuspensionYellowCards, rules.YellowCardBan
+		}
+		if length == 0 {
+			continue
+		}
+
+		start := order[card.MatchID] + 1
+		if banEnd[card.PlayerID] > start {
+			start = banEnd[card.PlayerID]
+		}
+		banEnd[card.PlayerID] = start + length
+
+		suspension := models.Suspension{
+			PlayerID:       card.PlayerID,
+			TeamID:         teamID,
+			CompetitionID:  rules.CompetitionID,
+			Reason:         reason,
+			TriggerMatchID: card.MatchID,
+			Matches:        length,
+			MatchIDs:       []int64{},
+		}
+		for i := start; i < len(matches) && i < start+length; i++ {
+			suspension.MatchIDs = append(suspension.MatchIDs, matches[i].ID)
+			if matches[i].Status == models.MatchLive || matches[i].Status == models.MatchFinished {
+				suspension.Served++
+			}
+		}
+		suspension.Remaining = length - suspension.Served
+
+		suspensions = append(suspensions, suspension)
+	}
+
+	return suspensions
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"soccer/pkg/models"
+)
+
+func TestComputeSuspensions(t *testing.T) {
+	rules := models.SuspensionRules{CompetitionID: 3, RedCardBan: 2, YellowCardLimit: 2, YellowCardBan: 1}
+	matches := []models.Match{
+		{ID: 10, Status: models.MatchFinished},
+		{ID: 11, Status: models.MatchFinished},
+		{ID: 12, Status: models.MatchFinished},
+		{ID: 13, Status: models.MatchScheduled},
+		{ID: 14, Status: models.MatchScheduled},
+	}
+	cards := []models.MatchEvent{
+		{ID: 4, MatchID: 11, PlayerID: 7, Type: models.EventRedCard, Minute: 80},
+		{ID: 3, MatchID: 11, PlayerID: 7, Type: models.EventYellowCard, Minute: 20},
+		{ID: 1, MatchID: 10, PlayerID: 7, Type: models.EventYellowCard, Minute: 30},
+		{ID: 2, MatchID: 10, PlayerID: 8, Type: models.EventYellowCard, Minute: 50},
+		{ID: 5, MatchID: 99, PlayerID: 8, Type: models.EventRedCard, Minute: 10},
+	}
+
+	suspensions := computeSuspensions(rules, 1, cards, matches)
+
+	assert.Equal(t, []models.Suspension{
+		{PlayerID: 7, TeamID: 1, CompetitionID: 3, Reason: models.SuspensionYellowCards, TriggerMatchID: 11,
+			Matches: 1, MatchIDs: []int64{12}, Served: 1, Remaining: 0},
+		{PlayerID: 7, TeamID: 1, CompetitionID: 3, Reason: models.SuspensionRedCard, TriggerMatchID: 11,
+			Matches: 2, MatchIDs: []int64{13, 14}, Served: 0, Remaining: 2},
+	}, suspensions)
+}
+
+func TestComputeSuspensionsBeyondSchedule(t *testing.T) {
+	rules := models.SuspensionRules{RedCardBan: 3}
+	matches := []models.Match{
+		{ID: 10, Status: models.MatchFinished},
+		{ID: 11, Status: models.MatchScheduled},
+	}
+	cards := []models.MatchEvent{{MatchID: 10, PlayerID: 7, Type: models.EventSecondYellow}}
+
+	assert.Equal(t, []models.Suspension{
+		{PlayerID: 7, TeamID: 1, Reason: models.SuspensionRedCard, TriggerMatchID: 10, Matches: 3, MatchIDs: []int64{11}, Remaining: 3},
+	}, computeSuspensions(rules, 1, cards, matches))
+}
+
+func TestComputeSuspensionsSecondYellow(t *testing.T) {
+	rules := models.SuspensionRules{RedCardBan: 1, YellowCardLimit: 2, YellowCardBan: 1}
+	matches := []models.Match{
+		{ID: 10, Status: models.MatchFinished},
+		{ID: 11, Status: models.MatchFinished},
+		{ID: 12, Status: models.MatchScheduled},
+		{ID: 13, Status: models.MatchScheduled},
+	}
+	// The first caution of match 10 goes with the sending off and does
+	// not add up with the caution of match 12.
+	cards := []models.MatchEvent{
+		{ID: 1, MatchID: 10, PlayerID: 7, Type: models.EventYellowCard, Minute: 20},
+		{ID: 2, MatchID: 10, PlayerID: 7, Type: models.EventSecondYellow, Minute: 70},
+		{ID: 3, MatchID: 12, PlayerID: 7, Type: models.EventYellowCard, Minute: 30},
+	}
+
+	assert.Equal(t, []models.Suspension{
+		{PlayerID: 7, TeamID: 1, Reason: models.SuspensionRedCard, TriggerMatchID: 10, Matches: 1, MatchIDs: []int64{11}, Served: 1},
+	}, computeSuspensions(rules, 1, cards, matches))
+}
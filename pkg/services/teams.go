@@ -33,6 +33,7 @@ func (s *teamsService) ListTeams(ctx context.Context) ([]models.Team, error) {
 			id
 			, name
 			, description
+			, venue_id
 			, created_at
 			, updated_at
 		FROM teams`
@@ -51,6 +52,7 @@ func (s *teamsService) GetTeam(ctx context.Context, id int64) (models.Team, erro
 			id
 			, name
 			, description
+			, venue_id
 			, created_at
 			, updated_at
 		FROM teams
@@ -65,10 +67,10 @@ func (s *teamsService) GetTeam(ctx context.Context, id int64) (models.Team, erro
 }
 
 func (s *teamsService) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
-	query := "INSERT INTO teams (name, description) VALUES ($1, $2) RETURNING id"
+	query := "INSERT INTO teams (name, description, venue_id) VALUES ($1, $2, $3) RETURNING id"
 
 	var id int64
-	if err := s.db.QueryRowxContext(ctx, query, team.Name, team.Description).Scan(&id); err != nil {
+	if err := s.db.QueryRowxContext(ctx, query, team.Name, team.Description, team.VenueID).Scan(&id); err != nil {
 		return models.Team{}, fmt.Errorf("insert new team: %s", err)
 	}
 
@@ -91,9 +93,9 @@ func (s *teamsService) DeleteTeam(ctx context.Context, id int64) error {
 }
 
 func (s *teamsService) UpdateTeam(ctx context.Context, team models.Team) (models.Team, error) {
-	query := `UPDATE teams SET name=$1, description=$2  Where id=$3`
+	query := `UPDATE teams SET name=$1, description=$2, venue_id=$3  Where id=$4`
 
-	if _, err := s.db.ExecContext(ctx, query, team.Name, team.Description, team.ID); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, team.Name, team.Description, team.VenueID, team.ID); err != nil {
 		return models.Team{}, fmt.Errorf("Update team: %s", err)
 	}
 
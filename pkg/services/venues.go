@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"soccer/pkg/models"
+)
+
+// VenuesService service interface.
+type VenuesService interface {
+	ListVenues(ctx context.Context) ([]models.Venue, error)
+	GetVenue(ctx context.Context, id int64) (models.Venue, error)
+	CreateVenue(ctx context.Context, venue models.Venue) (models.Venue, error)
+	DeleteVenue(ctx context.Context, id int64) error
+	UpdateVenue(ctx context.Context, venue models.Venue) (models.Venue, error)
+}
+
+type venuesService struct {
+	db *sqlx.DB
+}
+
+// NewVenuesService returns an initialized VenuesService implementation.
+func NewVenuesService(db *sqlx.DB) VenuesService {
+	return &venuesService{db: db}
+}
+
+func (s *venuesService) ListVenues(ctx context.Context) ([]models.Venue, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, city
+			, country
+			, capacity
+			, surface
+			, latitude
+			, longitude
+			, created_at
+			, updated_at
+		FROM venues`
+
+	var venues []models.Venue
+	if err := s.db.SelectContext(ctx, &venues, query); err != nil {
+		return nil, fmt.Errorf("get the list of venues: %s", err)
+	}
+
+	return venues, nil
+}
+
+func (s *venuesService) GetVenue(ctx context.Context, id int64) (models.Venue, error) {
+	query := `
+		SELECT
+			id
+			, name
+			, city
+			, country
+			, capacity
+			, surface
+			, latitude
+			, longitude
+			, created_at
+			, updated_at
+		FROM venues
+		WHERE id = $1`
+
+	var venue models.Venue
+	if err := s.db.GetContext(ctx, &venue, query, id); err != nil {
+		return models.Venue{}, fmt.Errorf("get a venue: %s", err)
+	}
+
+	return venue, nil
+}
+
+func (s *venuesService) CreateVenue(ctx context.Context, venue models.Venue) (models.Venue, error) {
+	if err := validateVenue(venue); err != nil {
+		return models.Venue{}, err
+	}
+
+	query := `
+		INSERT INTO venues (name, city, country, capacity, surface, latitude, longitude)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`
+
+	var id int64
+	if err := s.db.QueryRowxContext(ctx, query, venue.Name, venue.City, venue.Country, venue.Capacity,
+		venue.Surface, venue.Latitude, venue.Longitude).Scan(&id); err != nil {
+		return models.Venue{}, fmt.Errorf("insert new venue: %s", err)
+	}
+
+	newVenue, err := s.GetVenue(ctx, id)
+	if err != nil {
+		return models.Venue{}, fmt.Errorf("get new venue: %s", err)
+	}
+
+	return newVenue, nil
+}
+
+func (s *venuesService) DeleteVenue(ctx context.Context, id int64) error {
+	query := `DELETE FROM venues WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("delete a venue: %s", err)
+	}
+
+	return nil
+}
+
+func (s *venuesService) UpdateVenue(ctx context.Context, venue models.Venue) (models.Venue, error) {
+	if err := validateVenue(venue); err != nil {
+		return models.Venue{}, err
+	}
+
+	query := `
+		UPDATE venues SET
+			name=$1
+			, city=$2
+			, country=$3
+			, capacity=$4
+			, surface=$5
+			, latitude=$6
+			, longitude=$7
+			, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$8`
+
+	if _, err := s.db.ExecContext(ctx, query, venue.Name, venue.City, venue.Country, venue.Capacity,
+		venue.Surface, venue.Latitude, venue.Longitude, venue.ID); err != nil {
+		return models.Venue{}, fmt.Errorf("update venue: %s", err)
+	}
+
+	updatedVenue, err := s.GetVenue(ctx, venue.ID)
+	if err != nil {
+		return models.Venue{}, fmt.Errorf("get venue: %s", err)
+	}
+
+	return updatedVenue, nil
+}
+
+// validateVenue checks the venue coordinates, which must be given together.
+func validateVenue(venue models.Venue) error {
+	var problems []string
+	if (venue.Latitude == nil) != (venue.Longitude == nil) {
+		problems = append(problems, "latitude and longitude must be given together")
+	}
+	if venue.Latitude != nil && (*venue.Latitude < -90 || *venue.Latitude > 90) {
+		problems = append(problems, "latitude must be between -90 and 90")
+	}
+	if venue.Longitude != nil && (*venue.Longitude < -180 || *venue.Longitude > 180) {
+		problems = append(problems, "longitude must be between -180 and 180")
+	}
+	if len(problems) > 0 {
+		return NewValidationError(problems...)
+	}
+	return nil
+}